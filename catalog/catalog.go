@@ -0,0 +1,191 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"pixels-emulator/catalog/encode"
+	"pixels-emulator/catalog/message"
+	"pixels-emulator/core/model"
+	"pixels-emulator/role"
+)
+
+// NormalMode is the catalog of offers sold with the user balance.
+const NormalMode = "NORMAL"
+
+// RootPage identifies the page containing the whole catalog.
+const RootPage int32 = -1
+
+// NoOffer identifies pages opened without selecting an offer.
+const NoOffer int32 = -1
+
+// RoomAdsLayout is the layout of the pages selling the room promotions.
+const RoomAdsLayout = "roomads"
+
+// ErrPageNotFound rejects pages missing, hidden or forbidden to the user.
+var ErrPageNotFound = errors.New("catalog page not found")
+
+// Pages provides every catalog page by priority.
+func Pages(ctx context.Context, db *gorm.DB) ([]model.CatalogPage, error) {
+	var pages []model.CatalogPage
+	err := db.WithContext(ctx).Order("priority DESC").Order("id").Find(&pages).Error
+	return pages, err
+}
+
+// OfferIds provides the identifiers of the offers listed on each page, by priority.
+func OfferIds(ctx context.Context, db *gorm.DB) (map[uint][]int32, error) {
+
+	var offers []model.CatalogOffer
+	err := db.WithContext(ctx).Select("id", "page_id").Order("priority DESC").Order("id").Find(&offers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[uint][]int32)
+	for _, o := range offers {
+		res[o.PageID] = append(res[o.PageID], int32(o.ID))
+	}
+	return res, nil
+
+}
+
+// Allowed checks if a user has the permission required to open the page.
+func Allowed(p model.CatalogPage, u model.User) bool {
+	return p.Permission == "" || role.HasPermission(u, p.Permission)
+}
+
+// Tree provides the catalog tree as seen by a user. Pages hidden or forbidden
+// to the user are not listed, along every page they contain.
+func Tree(pages []model.CatalogPage, offers map[uint][]int32, u model.User) encode.Node {
+
+	children := make(map[uint][]model.CatalogPage)
+	for _, p := range pages {
+		children[p.ParentID] = append(children[p.ParentID], p)
+	}
+
+	seen := make(map[uint]bool)
+	var build func(parent uint) []encode.Node
+	build = func(parent uint) []encode.Node {
+		var nodes []encode.Node
+		for _, p := range children[parent] {
+			if seen[p.ID] || !p.Visible || !Allowed(p, u) {
+				continue
+			}
+			seen[p.ID] = true
+			nodes = append(nodes, encode.Node{
+				Visible:  true,
+				Icon:     int32(p.Icon),
+				PageId:   int32(p.ID),
+				Name:     p.Name,
+				Caption:  p.Caption,
+				Offers:   offers[p.ID],
+				Children: build(p.ID),
+			})
+		}
+		return nodes
+	}
+
+	return encode.Node{Visible: true, PageId: RootPage, Name: "root", Children: build(0)}
+
+}
+
+// Page provides a page along its offers and their items, if the user can open
+// the page and every page containing it.
+func Page(ctx context.Context, db *gorm.DB, id uint, u model.User) (*model.CatalogPage, error) {
+
+	var pages []*model.CatalogPage
+	err := db.WithContext(ctx).
+		Preload("Offers", func(db *gorm.DB) *gorm.DB { return db.Order("priority DESC").Order("id") }).
+		Preload("Offers.Items.Definition").
+		Where("id = ?", id).Limit(1).Find(&pages).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(pages) == 0 {
+		return nil, ErrPageNotFound
+	}
+
+	p := pages[0]
+	seen := make(map[uint]bool)
+	for c := *p; ; {
+		if seen[c.ID] || !c.Visible || !Allowed(c, u) {
+			return nil, ErrPageNotFound
+		}
+		seen[c.ID] = true
+
+		if c.ParentID == 0 {
+			return p, nil
+		}
+
+		var parents []model.CatalogPage
+		if err := db.WithContext(ctx).Where("id = ?", c.ParentID).Limit(1).Find(&parents).Error; err != nil {
+			return nil, err
+		}
+		if len(parents) == 0 {
+			return nil, ErrPageNotFound
+		}
+		c = parents[0]
+	}
+
+}
+
+// Offer provides an offer listed on the page.
+func Offer(p *model.CatalogPage, id uint) (*model.CatalogOffer, error) {
+	for i := range p.Offers {
+		if p.Offers[i].ID == id {
+			return &p.Offers[i], nil
+		}
+	}
+	return nil, ErrOfferNotFound
+}
+
+// EncodePage provides the protocol version of a page. The layouts read the
+// header, teaser and special images, followed by the header, detail and teaser texts.
+func EncodePage(p *model.CatalogPage, offer int32) *message.PagePacket {
+
+	offers := make([]encode.Offer, len(p.Offers))
+	for i := range p.Offers {
+		offers[i] = EncodeOffer(&p.Offers[i])
+	}
+
+	return &message.PagePacket{
+		PageId:  int32(p.ID),
+		Mode:    NormalMode,
+		Layout:  p.Layout,
+		Images:  []string{p.HeaderImage, p.TeaserImage, p.SpecialImage},
+		Texts:   []string{p.HeaderText, p.DetailText, p.TeaserText},
+		Offers:  offers,
+		OfferId: offer,
+	}
+
+}
+
+// EncodeOffer provides the protocol version of an offer, along its stock if limited.
+func EncodeOffer(o *model.CatalogOffer) encode.Offer {
+
+	products := make([]encode.Product, len(o.Items))
+	for i, it := range o.Items {
+		products[i] = encode.Product{
+			Type:   it.Definition.Type,
+			Sprite: int32(it.Definition.SpriteID),
+			Extra:  it.ExtraData,
+			Count:  int32(it.Amount),
+		}
+		if o.LimitedStack > 0 {
+			products[i].Limited = true
+			products[i].LimitedStack = int32(o.LimitedStack)
+			products[i].LimitedLeft = int32(max(0, o.LimitedStack-o.LimitedSells))
+		}
+	}
+
+	return encode.Offer{
+		OfferId:    int32(o.ID),
+		Name:       o.Name,
+		Credits:    int32(o.CostCredits),
+		Points:     int32(o.CostPoints),
+		PointsType: int32(o.PointsType),
+		Products:   products,
+		Bulk:       o.BulkAllowed && o.LimitedStack == 0,
+	}
+
+}
@@ -0,0 +1,80 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/catalog/encode"
+	"pixels-emulator/core/database"
+	"pixels-emulator/core/model"
+)
+
+// page creates a visible catalog page under a parent.
+func page(id, parent uint, name string) model.CatalogPage {
+	return model.CatalogPage{BaseModel: database.BaseModel{ID: id}, ParentID: parent, Name: name, Caption: name, Visible: true}
+}
+
+// TestTree verifies pages are nested and those hidden or forbidden are not listed.
+func TestTree(t *testing.T) {
+	staff := page(4, 0, "staff")
+	staff.Permission = "pixels.catalog.staff"
+	hidden := page(5, 1, "hidden")
+	hidden.Visible = false
+
+	pages := []model.CatalogPage{page(1, 0, "furni"), page(2, 1, "chairs"), page(3, 0, "pets"), staff, hidden, page(6, 5, "orphan")}
+	root := Tree(pages, map[uint][]int32{2: {7, 8}}, model.User{})
+
+	assert.Equal(t, RootPage, root.PageId)
+	assert.Len(t, root.Children, 2)
+	assert.Equal(t, "furni", root.Children[0].Name)
+	assert.Equal(t, "pets", root.Children[1].Name)
+	assert.Equal(t, []encode.Node{{Visible: true, PageId: 2, Name: "chairs", Caption: "chairs", Offers: []int32{7, 8}}}, root.Children[0].Children,
+		"pages under hidden pages are not listed")
+
+	u := model.User{Roles: []model.Role{{Priority: 1, Permissions: []model.RolePermission{{Permission: "pixels.catalog.staff"}}}}}
+	assert.Len(t, Tree(pages, nil, u).Children, 3)
+}
+
+// TestTree_Cycle verifies pages containing each other are listed once.
+func TestTree_Cycle(t *testing.T) {
+	root := Tree([]model.CatalogPage{page(1, 0, "a"), page(2, 1, "b"), page(1, 2, "a")}, nil, model.User{})
+	assert.Len(t, root.Children, 1)
+	assert.Len(t, root.Children[0].Children, 1)
+	assert.Empty(t, root.Children[0].Children[0].Children)
+}
+
+// TestEncodeOffer verifies the products and the stock of limited offers.
+func TestEncodeOffer(t *testing.T) {
+	o := &model.CatalogOffer{Name: "chair", CostCredits: 3, BulkAllowed: true, Items: []model.CatalogOfferItem{
+		{Definition: model.ItemDefinition{Type: model.FloorItem, SpriteID: 13}, Amount: 2},
+	}}
+	o.ID = 4
+
+	enc := EncodeOffer(o)
+	assert.Equal(t, int32(4), enc.OfferId)
+	assert.True(t, enc.Bulk)
+	assert.Equal(t, []encode.Product{{Type: encode.FloorProduct, Sprite: 13, Count: 2}}, enc.Products)
+
+	o.LimitedStack, o.LimitedSells = 10, 3
+	enc = EncodeOffer(o)
+	assert.False(t, enc.Bulk, "limited offers are bought once")
+	assert.True(t, enc.Products[0].Limited)
+	assert.Equal(t, int32(10), enc.Products[0].LimitedStack)
+	assert.Equal(t, int32(7), enc.Products[0].LimitedLeft)
+}
+
+// TestEncodePage verifies the images and texts are sent in layout order.
+func TestEncodePage(t *testing.T) {
+	p := page(2, 0, "chairs")
+	p.Layout, p.HeaderImage, p.TeaserImage, p.HeaderText, p.DetailText = "default_3x3", "header", "teaser", "Chairs", "Sit down"
+	p.Offers = []model.CatalogOffer{{Name: "chair"}}
+
+	pck := EncodePage(&p, NoOffer)
+	assert.Equal(t, []string{"header", "teaser", ""}, pck.Images)
+	assert.Equal(t, []string{"Chairs", "Sit down", ""}, pck.Texts)
+	assert.Len(t, pck.Offers, 1)
+	assert.Equal(t, NoOffer, pck.OfferId)
+
+	_, err := Offer(&p, 9)
+	assert.ErrorIs(t, err, ErrOfferNotFound)
+}
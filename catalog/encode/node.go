@@ -0,0 +1,80 @@
+package encode
+
+import "pixels-emulator/core/protocol"
+
+// Node represents a page of the catalog tree along its children.
+type Node struct {
+	protocol.Encodable
+	Visible  bool    // Visible determines if the page is listed.
+	Icon     int32   // Icon is the image displayed along the caption.
+	PageId   int32   // PageId is the identifier of the page.
+	Name     string  // Name is the code of the page.
+	Caption  string  // Caption is the display name of the page.
+	Offers   []int32 // Offers are the identifiers of the offers listed on the page.
+	Children []Node  // Children are the pages contained by the page.
+}
+
+// Encode adds current data to a packet, followed by every child.
+func (e *Node) Encode(pck *protocol.RawPacket) {
+	pck.AddBoolean(e.Visible)
+	pck.AddInt(e.Icon)
+	pck.AddInt(e.PageId)
+	pck.AddString(e.Name)
+	pck.AddString(e.Caption)
+	pck.AddInt(int32(len(e.Offers)))
+	for _, o := range e.Offers {
+		pck.AddInt(o)
+	}
+	pck.AddInt(int32(len(e.Children)))
+	for _, c := range e.Children {
+		c.Encode(pck)
+	}
+}
+
+// Decode reads the node and its children from a packet.
+func (e *Node) Decode(pck *protocol.RawPacket) error {
+
+	var err error
+	if e.Visible, err = pck.ReadBoolean(); err != nil {
+		return err
+	}
+	if e.Icon, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	if e.PageId, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	if e.Name, err = pck.ReadString(); err != nil {
+		return err
+	}
+	if e.Caption, err = pck.ReadString(); err != nil {
+		return err
+	}
+
+	n, err := pck.ReadInt()
+	if err != nil {
+		return err
+	}
+	e.Offers = nil
+	for ; n > 0; n-- {
+		o, err := pck.ReadInt()
+		if err != nil {
+			return err
+		}
+		e.Offers = append(e.Offers, o)
+	}
+
+	if n, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	e.Children = nil
+	for ; n > 0; n-- {
+		c := Node{}
+		if err := c.Decode(pck); err != nil {
+			return err
+		}
+		e.Children = append(e.Children, c)
+	}
+	return nil
+
+}
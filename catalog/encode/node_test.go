@@ -0,0 +1,30 @@
+package encode
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"testing"
+)
+
+// TestNode_Encode check if encode is done correctly along the children.
+func TestNode_Encode(t *testing.T) {
+
+	node := &Node{
+		Visible: true, PageId: -1, Name: "root",
+		Children: []Node{
+			{Visible: true, Icon: 1, PageId: 2, Name: "furni", Caption: "Furniture", Offers: []int32{4, 5}},
+			{Visible: true, Icon: 2, PageId: 3, Name: "pets", Caption: "Pets", Children: []Node{{PageId: 6, Name: "dogs", Caption: "Dogs"}}},
+		},
+	}
+
+	pck := protocol.NewPacket(100)
+	node.Encode(&pck)
+
+	dec, err := protocol.FromBytes(pck.ToBytes())
+	assert.NoError(t, err, "Raw decoding must not have an error")
+
+	decNode := &Node{}
+	assert.NoError(t, decNode.Decode(dec), "Decoding must not have an error")
+	assert.Equal(t, node, decNode)
+
+}
@@ -0,0 +1,160 @@
+package encode
+
+import "pixels-emulator/core/protocol"
+
+const (
+	FloorProduct = "s" // FloorProduct defines the products placed on the room tiles.
+	WallProduct  = "i" // WallProduct defines the products placed on the room walls.
+	BadgeProduct = "b" // BadgeProduct defines the badges given by an offer.
+)
+
+// Product represents an amount of a kind of item given by an offer.
+type Product struct {
+	protocol.Encodable
+	Type         string // Type defines the kind of product.
+	Sprite       int32  // Sprite is the identifier of the furniture in the furnidata.
+	Extra        string // Extra is the initial state of the product, or the badge code.
+	Count        int32  // Count is the amount of items given.
+	Limited      bool   // Limited determines if the product has a limited stock.
+	LimitedStack int32  // LimitedStack is the amount of limited items sold.
+	LimitedLeft  int32  // LimitedLeft is the amount of limited items left.
+}
+
+// Encode adds current data to a packet. Badges only send their code, and only
+// limited products send their stock.
+func (e *Product) Encode(pck *protocol.RawPacket) {
+	pck.AddString(e.Type)
+	if e.Type == BadgeProduct {
+		pck.AddString(e.Extra)
+		return
+	}
+	pck.AddInt(e.Sprite)
+	pck.AddString(e.Extra)
+	pck.AddInt(e.Count)
+	pck.AddBoolean(e.Limited)
+	if e.Limited {
+		pck.AddInt(e.LimitedStack)
+		pck.AddInt(e.LimitedLeft)
+	}
+}
+
+// Decode reads the product from a packet.
+func (e *Product) Decode(pck *protocol.RawPacket) error {
+
+	var err error
+	if e.Type, err = pck.ReadString(); err != nil {
+		return err
+	}
+	if e.Type == BadgeProduct {
+		e.Count = 1
+		e.Extra, err = pck.ReadString()
+		return err
+	}
+
+	if e.Sprite, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	if e.Extra, err = pck.ReadString(); err != nil {
+		return err
+	}
+	if e.Count, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	if e.Limited, err = pck.ReadBoolean(); err != nil {
+		return err
+	}
+	if !e.Limited {
+		return nil
+	}
+	if e.LimitedStack, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	e.LimitedLeft, err = pck.ReadInt()
+	return err
+
+}
+
+// Offer represents a set of products sold on a catalog page.
+type Offer struct {
+	protocol.Encodable
+	OfferId    int32     // OfferId is the identifier of the offer.
+	Name       string    // Name is the localization code of the offer.
+	Rent       bool      // Rent determines if the products are rented.
+	Credits    int32     // Credits is the amount of credits charged.
+	Points     int32     // Points is the amount of activity points charged.
+	PointsType int32     // PointsType is the currency of the activity points.
+	Giftable   bool      // Giftable determines if the offer can be bought as a gift.
+	Products   []Product // Products are the items given by the offer.
+	ClubLevel  int32     // ClubLevel is the club membership required.
+	Bulk       bool      // Bulk determines if the offer can be bought many times at once.
+	Pet        bool      // Pet determines if the offer sells a pet.
+	Preview    string    // Preview is the image displayed instead of the products.
+}
+
+// Encode adds current data to a packet.
+func (e *Offer) Encode(pck *protocol.RawPacket) {
+	pck.AddInt(e.OfferId)
+	pck.AddString(e.Name)
+	pck.AddBoolean(e.Rent)
+	pck.AddInt(e.Credits)
+	pck.AddInt(e.Points)
+	pck.AddInt(e.PointsType)
+	pck.AddBoolean(e.Giftable)
+	pck.AddInt(int32(len(e.Products)))
+	for _, p := range e.Products {
+		p.Encode(pck)
+	}
+	pck.AddInt(e.ClubLevel)
+	pck.AddBoolean(e.Bulk)
+	pck.AddBoolean(e.Pet)
+	pck.AddString(e.Preview)
+}
+
+// Decode reads the offer from a packet.
+func (e *Offer) Decode(pck *protocol.RawPacket) error {
+
+	var err error
+	if e.OfferId, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	if e.Name, err = pck.ReadString(); err != nil {
+		return err
+	}
+	if e.Rent, err = pck.ReadBoolean(); err != nil {
+		return err
+	}
+	for _, v := range []*int32{&e.Credits, &e.Points, &e.PointsType} {
+		if *v, err = pck.ReadInt(); err != nil {
+			return err
+		}
+	}
+	if e.Giftable, err = pck.ReadBoolean(); err != nil {
+		return err
+	}
+
+	n, err := pck.ReadInt()
+	if err != nil {
+		return err
+	}
+	e.Products = nil
+	for ; n > 0; n-- {
+		p := Product{}
+		if err := p.Decode(pck); err != nil {
+			return err
+		}
+		e.Products = append(e.Products, p)
+	}
+
+	if e.ClubLevel, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	if e.Bulk, err = pck.ReadBoolean(); err != nil {
+		return err
+	}
+	if e.Pet, err = pck.ReadBoolean(); err != nil {
+		return err
+	}
+	e.Preview, err = pck.ReadString()
+	return err
+
+}
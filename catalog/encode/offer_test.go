@@ -0,0 +1,31 @@
+package encode
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"testing"
+)
+
+// TestOffer_Encode check if encode is done correctly for every kind of product.
+func TestOffer_Encode(t *testing.T) {
+
+	offer := &Offer{
+		OfferId: 4, Name: "chair", Credits: 3, Points: 5, PointsType: 0, Bulk: true,
+		Products: []Product{
+			{Type: FloorProduct, Sprite: 13, Count: 2},
+			{Type: WallProduct, Sprite: 4001, Extra: "12", Count: 1, Limited: true, LimitedStack: 100, LimitedLeft: 42},
+			{Type: BadgeProduct, Extra: "ADM", Count: 1},
+		},
+	}
+
+	pck := protocol.NewPacket(100)
+	offer.Encode(&pck)
+
+	dec, err := protocol.FromBytes(pck.ToBytes())
+	assert.NoError(t, err, "Raw decoding must not have an error")
+
+	decOffer := &Offer{}
+	assert.NoError(t, decOffer.Decode(dec), "Decoding must not have an error")
+	assert.Equal(t, offer, decOffer)
+
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/catalog"
+	"pixels-emulator/catalog/encode"
+	"pixels-emulator/catalog/message"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/user"
+)
+
+// CatalogIndexHandler provides the catalog tree a player can open.
+type CatalogIndexHandler struct {
+	logger    *zap.Logger // logger for packet processing details.
+	db        *gorm.DB    // db is the database to read the catalog.
+	userStore user.Store  // userStore is the user store to check user related conn.
+}
+
+// Handle processes the incoming catalog index packet. Only the normal catalog is
+// served, any other mode is answered with an empty tree.
+func (h *CatalogIndexHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	pck, ok := raw.(*message.GetIndexPacket)
+	if !ok {
+		h.logger.Error("cannot cast catalog index packet, skipping processing")
+		return
+	}
+
+	if pck.Mode != catalog.NormalMode {
+		conn.SendPacket(&message.IndexPacket{Root: encode.Node{Visible: true, PageId: catalog.RootPage, Name: "root"}, Mode: pck.Mode})
+		return
+	}
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for catalog index", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	res := <-p.Record(ctx)
+	if res.Error != nil {
+		h.logger.Error("error loading player record for catalog index", zap.String("identifier", conn.Identifier()), zap.Error(res.Error))
+		return
+	}
+
+	pages, err := catalog.Pages(ctx, h.db)
+	if err != nil {
+		h.logger.Error("error loading catalog pages", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	offers, err := catalog.OfferIds(ctx, h.db)
+	if err != nil {
+		h.logger.Error("error loading catalog offers", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	conn.SendPacket(&message.IndexPacket{Root: catalog.Tree(pages, offers, *res.Data), Mode: pck.Mode})
+
+}
+
+// NewCatalogIndex creates a new handler instance.
+func NewCatalogIndex() *CatalogIndexHandler {
+	return &CatalogIndexHandler{
+		logger:    server.GetServer().Logger(),
+		db:        server.GetServer().Database(),
+		userStore: server.GetServer().UserStore(),
+	}
+}
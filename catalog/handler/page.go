@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/catalog"
+	"pixels-emulator/catalog/message"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/user"
+)
+
+// CatalogPageHandler provides the pages of the catalog along their offers.
+type CatalogPageHandler struct {
+	logger    *zap.Logger // logger for packet processing details.
+	db        *gorm.DB    // db is the database to read the catalog.
+	userStore user.Store  // userStore is the user store to check user related conn.
+}
+
+// Handle processes the incoming catalog page packet.
+func (h *CatalogPageHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	pck, ok := raw.(*message.GetPagePacket)
+	if !ok {
+		h.logger.Error("cannot cast catalog page packet, skipping processing")
+		return
+	}
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for catalog page", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	res := <-p.Record(ctx)
+	if res.Error != nil {
+		h.logger.Error("error loading player record for catalog page", zap.String("identifier", conn.Identifier()), zap.Error(res.Error))
+		return
+	}
+
+	page, err := catalog.Page(ctx, h.db, uint(pck.Page), *res.Data)
+	if errors.Is(err, catalog.ErrPageNotFound) {
+		h.logger.Debug("catalog page not available", zap.String("identifier", conn.Identifier()), zap.Int32("page", pck.Page))
+		return
+	}
+	if err != nil {
+		h.logger.Error("error loading catalog page", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	p.OpenCatalogPage(page.ID)
+	conn.SendPacket(catalog.EncodePage(page, pck.Offer))
+
+}
+
+// NewCatalogPage creates a new handler instance.
+func NewCatalogPage() *CatalogPageHandler {
+	return &CatalogPageHandler{
+		logger:    server.GetServer().Logger(),
+		db:        server.GetServer().Database(),
+		userStore: server.GetServer().UserStore(),
+	}
+}
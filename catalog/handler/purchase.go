@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/catalog"
+	"pixels-emulator/catalog/message"
+	"pixels-emulator/core/config"
+	"pixels-emulator/core/model"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/inventory"
+	"pixels-emulator/user"
+	userMsg "pixels-emulator/user/message"
+)
+
+// inventoryNotUpdated is the alert sent when the purchased items could not be shown in the inventory.
+const inventoryNotUpdated = "Your purchase was made, but your inventory could not be updated. Please reload it."
+
+// CatalogPurchaseHandler manages the players buying the catalog offers with their balance.
+// Purchases are rate limited for each player by the packet rate.
+type CatalogPurchaseHandler struct {
+	logger    *zap.Logger    // logger for packet processing details.
+	db        *gorm.DB       // db is the database to persist the purchases.
+	userStore user.Store     // userStore is the user store to check user related conn.
+	cfg       *config.Config // cfg is the configuration to check the inventories capacity.
+}
+
+// Handle processes the incoming purchase packet.
+func (h *CatalogPurchaseHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	pck, ok := raw.(*message.PurchasePacket)
+	if !ok {
+		h.logger.Error("cannot cast catalog purchase packet, skipping processing")
+		return
+	}
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for catalog purchase", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	res := <-p.Record(ctx)
+	if res.Error != nil {
+		h.logger.Error("error loading player record for catalog purchase", zap.String("identifier", conn.Identifier()), zap.Error(res.Error))
+		return
+	}
+	u := *res.Data
+
+	page, err := catalog.Page(ctx, h.db, uint(pck.Page), u)
+	if err != nil {
+		h.reject(conn, err)
+		return
+	}
+
+	offer, err := catalog.Offer(page, uint(pck.Offer))
+	if err != nil {
+		h.reject(conn, err)
+		return
+	}
+
+	amount := int(pck.Amount)
+	if err := catalog.ValidAmount(offer, amount); err != nil {
+		h.reject(conn, err)
+		return
+	}
+
+	if credits, points := catalog.Affordable(u, offer, amount); !credits || !points {
+		conn.SendPacket(&message.NotEnoughBalancePacket{Credits: !credits, Points: !points, PointsType: int32(offer.PointsType)})
+		return
+	}
+
+	inv := p.LoadedInventory()
+	n := len(catalog.Items(u, offer, amount))
+	if err := inventory.Fits(ctx, h.db, inv, u.ID, int(h.cfg.Inventory.MaxItems), n); err != nil {
+		h.reject(conn, err)
+		return
+	}
+
+	items, err := catalog.Purchase(ctx, h.db, u, offer, amount)
+	if errors.Is(err, catalog.ErrNotEnoughBalance) {
+		conn.SendPacket(&message.NotEnoughBalancePacket{Credits: offer.CostCredits > 0, Points: offer.CostPoints > 0, PointsType: int32(offer.PointsType)})
+		return
+	}
+	if errors.Is(err, catalog.ErrSoldOut) {
+		h.logger.Debug("limited catalog offer sold out", zap.String("identifier", conn.Identifier()), zap.Uint("offer", offer.ID))
+		conn.SendPacket(&message.SoldOutPacket{})
+		return
+	}
+	if err != nil {
+		h.reject(conn, err)
+		return
+	}
+
+	conn.SendPacket(&message.PurchaseOKPacket{Offer: catalog.EncodeOffer(offer)})
+	if inv != nil {
+		if err := inv.Push(conn, items...); err != nil {
+			h.logger.Warn("purchased items not added to the loaded inventory", zap.String("identifier", conn.Identifier()), zap.Error(err))
+			h.resend(ctx, p, conn)
+		}
+	}
+
+	h.balance(ctx, p, conn)
+
+	if catalog.SoldOut(offer) {
+		h.soldOut(ctx, page)
+	}
+
+}
+
+// reject notifies the player a purchase was not made. Purchases not allowed
+// to the player are told apart from the server failures.
+func (h *CatalogPurchaseHandler) reject(conn protocol.Connection, err error) {
+
+	switch {
+	case errors.Is(err, catalog.ErrPageNotFound), errors.Is(err, catalog.ErrOfferNotFound), errors.Is(err, catalog.ErrPurchaseAmount):
+		h.logger.Debug("catalog purchase not allowed", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		conn.SendPacket(&message.PurchaseNotAllowedPacket{Code: message.IllegalPurchase})
+	case errors.Is(err, inventory.ErrInventoryFull), errors.Is(err, catalog.ErrSoldOut):
+		h.logger.Debug("catalog purchase rejected", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		conn.SendPacket(&message.PurchaseErrorPacket{Code: message.ServerError})
+	default:
+		h.logger.Error("error purchasing catalog offer", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		conn.SendPacket(&message.PurchaseErrorPacket{Code: message.ServerError})
+	}
+
+}
+
+// soldOut refreshes the page of a limited offer out of stock for every
+// player looking at it, so the offer can not be bought anymore. Only the
+// buyers failing to get it are told it is sold out.
+func (h *CatalogPurchaseHandler) soldOut(ctx context.Context, page *model.CatalogPage) {
+
+	players, err := h.userStore.Records().GetAll(ctx)
+	if err != nil {
+		h.logger.Error("error listing players for sold out catalog offer", zap.Uint("page", page.ID), zap.Error(err))
+		return
+	}
+
+	pck := catalog.EncodePage(page, catalog.NoOffer)
+	for _, p := range players {
+		if p.CatalogPage() != page.ID {
+			continue
+		}
+		p.Conn().SendPacket(pck)
+	}
+
+}
+
+// resend loads again the inventory of the player and sends it, as the purchased
+// items are stored but could not be added to the loaded one.
+func (h *CatalogPurchaseHandler) resend(ctx context.Context, p *user.Player, conn protocol.Connection) {
+
+	inv, err := p.ReloadInventory(ctx, h.db, int(h.cfg.Inventory.MaxItems))
+	if err != nil {
+		h.logger.Error("error reloading inventory after purchase", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		conn.SendPacket(&userMsg.AlertPacket{Message: inventoryNotUpdated})
+		return
+	}
+
+	for _, f := range inv.Fragments() {
+		conn.SendPacket(f)
+	}
+
+}
+
+// balance sends the updated credits and activity points of the player.
+func (h *CatalogPurchaseHandler) balance(ctx context.Context, p *user.Player, conn protocol.Connection) {
+
+	res := <-p.Record(ctx)
+	if res.Error != nil {
+		h.logger.Error("error loading player balance after purchase", zap.String("identifier", conn.Identifier()), zap.Error(res.Error))
+		return
+	}
+
+	conn.SendPacket(&userMsg.CreditsPacket{Credits: int32(res.Data.Credits)})
+	conn.SendPacket(&userMsg.CurrencyPacket{Currencies: catalog.Currencies(*res.Data)})
+
+}
+
+// NewCatalogPurchase creates a new handler instance.
+func NewCatalogPurchase() *CatalogPurchaseHandler {
+	return &CatalogPurchaseHandler{
+		logger:    server.GetServer().Logger(),
+		db:        server.GetServer().Database(),
+		userStore: server.GetServer().UserStore(),
+		cfg:       server.GetServer().Config(),
+	}
+}
@@ -0,0 +1,65 @@
+package catalog
+
+import (
+	"gorm.io/gorm"
+	"pixels-emulator/core/model"
+	"sync"
+)
+
+// Stock serializes the sales of each limited offer, so concurrent buyers take
+// their serial numbers one after another.
+type Stock struct {
+	mu    sync.Mutex           // mu guards the sales.
+	sales map[uint]*sync.Mutex // sales are the locks of each limited offer.
+}
+
+// NewStock creates the sales registry of the limited offers.
+func NewStock() *Stock {
+	return &Stock{sales: make(map[uint]*sync.Mutex)}
+}
+
+// Sell runs a sale of a limited offer once every previous sale of the offer finished.
+func (s *Stock) Sell(offer uint, sale func() error) error {
+
+	s.mu.Lock()
+	l, ok := s.sales[offer]
+	if !ok {
+		l = &sync.Mutex{}
+		s.sales[offer] = l
+	}
+	s.mu.Unlock()
+
+	l.Lock()
+	defer l.Unlock()
+	return sale()
+
+}
+
+// limited serializes the buyers of the limited offers.
+var limited = NewStock()
+
+// SoldOut checks if a limited offer has no stock left.
+func SoldOut(o *model.CatalogOffer) bool {
+	return o.LimitedStack > 0 && o.LimitedSells >= o.LimitedStack
+}
+
+// takeSerial takes a unit of the limited offer stock, providing its serial number.
+// The stock is only taken while there are units left, so the serials stay
+// sequential even with other emulators sharing the database.
+func takeSerial(tx *gorm.DB, o *model.CatalogOffer) (int, error) {
+
+	res := tx.Model(&model.CatalogOffer{}).
+		Where("id = ? AND limited_sells < limited_stack", o.ID).
+		Update("limited_sells", gorm.Expr("limited_sells + 1"))
+	if res.Error != nil {
+		return 0, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return 0, ErrSoldOut
+	}
+
+	var serial int
+	err := tx.Model(&model.CatalogOffer{}).Where("id = ?", o.ID).Select("limited_sells").Scan(&serial).Error
+	return serial, err
+
+}
@@ -0,0 +1,64 @@
+package catalog
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/model"
+)
+
+// TestStock_Sell checks concurrent buyers take sequential serials without exceeding the stock.
+func TestStock_Sell(t *testing.T) {
+
+	const stack, buyers = 50, 200
+	s := NewStock()
+	sells := 0
+
+	var mu sync.Mutex
+	serials := make(map[int]bool)
+	soldOut := 0
+
+	var wg sync.WaitGroup
+	for n := 0; n < buyers; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			serial := 0
+			err := s.Sell(1, func() error {
+				if sells >= stack {
+					return ErrSoldOut
+				}
+				current := sells
+				sells = current + 1
+				serial = sells
+				return nil
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				assert.ErrorIs(t, err, ErrSoldOut)
+				soldOut++
+				return
+			}
+			assert.False(t, serials[serial], "serial %d given twice", serial)
+			serials[serial] = true
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, stack, sells)
+	assert.Equal(t, buyers-stack, soldOut)
+	for n := 1; n <= stack; n++ {
+		assert.True(t, serials[n], "serial %d not given", n)
+	}
+
+}
+
+// TestSoldOut checks only limited offers without stock left are sold out.
+func TestSoldOut(t *testing.T) {
+	assert.False(t, SoldOut(&model.CatalogOffer{}))
+	assert.False(t, SoldOut(&model.CatalogOffer{LimitedStack: 2, LimitedSells: 1}))
+	assert.True(t, SoldOut(&model.CatalogOffer{LimitedStack: 2, LimitedSells: 2}))
+}
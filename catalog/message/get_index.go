@@ -0,0 +1,39 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// GetIndexCode is the unique identifier for the packet
+const GetIndexCode = 1195
+
+// GetIndexPacket requests the catalog tree of a mode.
+type GetIndexPacket struct {
+	Mode string // Mode is the catalog requested, as NORMAL or BUILDERS_CLUB.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *GetIndexPacket) Id() uint16 {
+	return GetIndexCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *GetIndexPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *GetIndexPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeGetIndexPacket composes a new instance of the packet.
+func ComposeGetIndexPacket(pck protocol.RawPacket) (*GetIndexPacket, error) {
+
+	m, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetIndexPacket{Mode: m}, nil
+
+}
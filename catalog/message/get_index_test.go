@@ -0,0 +1,31 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeGetIndexPacket verifies the packet is composed from its content.
+func TestComposeGetIndexPacket(t *testing.T) {
+	raw := protocol.NewPacket(GetIndexCode)
+	raw.AddString("value1")
+
+	pck, err := ComposeGetIndexPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, "value1", pck.Mode)
+
+	_, err = ComposeGetIndexPacket(protocol.NewPacket(GetIndexCode))
+	assert.Error(t, err)
+}
+
+// TestGetIndexPacket_Integrity tests packet ID, deadline, and rate.
+func TestGetIndexPacket_Integrity(t *testing.T) {
+	pck := &GetIndexPacket{}
+	assert.Equal(t, uint16(GetIndexCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,51 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// GetPageCode is the unique identifier for the packet
+const GetPageCode = 412
+
+// GetPagePacket requests a page of the catalog along its offers.
+type GetPagePacket struct {
+	Page  int32  // Page is the identifier of the page.
+	Offer int32  // Offer is the identifier of the offer selected, -1 if none.
+	Mode  string // Mode is the catalog requested.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *GetPagePacket) Id() uint16 {
+	return GetPageCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *GetPagePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *GetPagePacket) Deadline() uint {
+	return 500
+}
+
+// ComposeGetPagePacket composes a new instance of the packet.
+func ComposeGetPagePacket(pck protocol.RawPacket) (*GetPagePacket, error) {
+
+	pa, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetPagePacket{Page: pa, Offer: o, Mode: m}, nil
+
+}
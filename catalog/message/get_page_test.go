@@ -0,0 +1,35 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeGetPagePacket verifies the packet is composed from its content.
+func TestComposeGetPagePacket(t *testing.T) {
+	raw := protocol.NewPacket(GetPageCode)
+	raw.AddInt(int32(1))
+	raw.AddInt(int32(2))
+	raw.AddString("value3")
+
+	pck, err := ComposeGetPagePacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.Page)
+	assert.Equal(t, int32(2), pck.Offer)
+	assert.Equal(t, "value3", pck.Mode)
+
+	_, err = ComposeGetPagePacket(protocol.NewPacket(GetPageCode))
+	assert.Error(t, err)
+}
+
+// TestGetPagePacket_Integrity tests packet ID, deadline, and rate.
+func TestGetPagePacket_Integrity(t *testing.T) {
+	pck := &GetPagePacket{}
+	assert.Equal(t, uint16(GetPageCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,41 @@
+package message
+
+import (
+	"pixels-emulator/catalog/encode"
+	"pixels-emulator/core/protocol"
+)
+
+// IndexCode is the unique identifier for the packet
+const IndexCode = 1032
+
+// IndexPacket provides the catalog tree of a mode, starting from its root page.
+type IndexPacket struct {
+	Root         encode.Node // Root is the page containing the whole catalog.
+	NewAdditions bool        // NewAdditions determines if there are offers not seen yet.
+	Mode         string      // Mode is the catalog provided.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *IndexPacket) Id() uint16 {
+	return IndexCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *IndexPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *IndexPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *IndexPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(IndexCode)
+	p.Root.Encode(&pck)
+	pck.AddBoolean(p.NewAdditions)
+	pck.AddString(p.Mode)
+	return pck
+}
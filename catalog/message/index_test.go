@@ -0,0 +1,40 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/catalog/encode"
+	"pixels-emulator/core/protocol"
+)
+
+// TestIndexPacket_Serialize verifies the packet serialization.
+func TestIndexPacket_Serialize(t *testing.T) {
+	pck := &IndexPacket{
+		Root: encode.Node{Visible: true, PageId: -1, Name: "root", Children: []encode.Node{{Visible: true, PageId: 2, Name: "furni", Caption: "Furniture"}}},
+		Mode: "NORMAL",
+	}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	root := encode.Node{}
+	assert.NoError(t, root.Decode(raw))
+	assert.Equal(t, pck.Root, root)
+
+	news, _ := raw.ReadBoolean()
+	mode, err := raw.ReadString()
+	assert.NoError(t, err)
+	assert.False(t, news)
+	assert.Equal(t, "NORMAL", mode)
+}
+
+// TestIndexPacket_Integrity tests packet ID, deadline, and rate.
+func TestIndexPacket_Integrity(t *testing.T) {
+	pck := &IndexPacket{}
+	assert.Equal(t, uint16(IndexCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,38 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// NotEnoughBalanceCode is the unique identifier for the packet
+const NotEnoughBalanceCode = 3914
+
+// NotEnoughBalancePacket notifies a purchase rejected for the user balance.
+type NotEnoughBalancePacket struct {
+	Credits    bool  // Credits determines if the credits are not enough.
+	Points     bool  // Points determines if the activity points are not enough.
+	PointsType int32 // PointsType is the currency of the activity points.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *NotEnoughBalancePacket) Id() uint16 {
+	return NotEnoughBalanceCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *NotEnoughBalancePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *NotEnoughBalancePacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *NotEnoughBalancePacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(NotEnoughBalanceCode)
+	pck.AddBoolean(p.Credits)
+	pck.AddBoolean(p.Points)
+	pck.AddInt(p.PointsType)
+	return pck
+}
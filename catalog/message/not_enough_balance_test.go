@@ -0,0 +1,39 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestNotEnoughBalancePacket_Serialize verifies the packet serialization.
+func TestNotEnoughBalancePacket_Serialize(t *testing.T) {
+	pck := &NotEnoughBalancePacket{Credits: true, Points: true, PointsType: int32(3)}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(NotEnoughBalanceCode), raw.GetHeader())
+
+	c0, err := raw.ReadBoolean()
+	assert.NoError(t, err)
+	assert.Equal(t, true, c0)
+
+	p1, err := raw.ReadBoolean()
+	assert.NoError(t, err)
+	assert.Equal(t, true, p1)
+
+	p2, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), p2)
+}
+
+// TestNotEnoughBalancePacket_Integrity tests packet ID, deadline, and rate.
+func TestNotEnoughBalancePacket_Integrity(t *testing.T) {
+	pck := &NotEnoughBalancePacket{}
+	assert.Equal(t, uint16(NotEnoughBalanceCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
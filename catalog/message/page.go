@@ -0,0 +1,59 @@
+package message
+
+import (
+	"pixels-emulator/catalog/encode"
+	"pixels-emulator/core/protocol"
+)
+
+// PageCode is the unique identifier for the packet
+const PageCode = 804
+
+// PagePacket provides a page of the catalog along its offers.
+// The images and texts are consumed in order by the page layout.
+type PagePacket struct {
+	PageId   int32          // PageId is the identifier of the page.
+	Mode     string         // Mode is the catalog of the page.
+	Layout   string         // Layout is the client template used to display the page.
+	Images   []string       // Images are the images of the layout.
+	Texts    []string       // Texts are the texts of the layout.
+	Offers   []encode.Offer // Offers are the offers listed on the page.
+	OfferId  int32          // OfferId is the offer selected, -1 if none.
+	Seasonal bool           // Seasonal determines if the seasonal currency is accepted as credits.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *PagePacket) Id() uint16 {
+	return PageCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *PagePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *PagePacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *PagePacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(PageCode)
+	pck.AddInt(p.PageId)
+	pck.AddString(p.Mode)
+	pck.AddString(p.Layout)
+	for _, l := range [][]string{p.Images, p.Texts} {
+		pck.AddInt(int32(len(l)))
+		for _, s := range l {
+			pck.AddString(s)
+		}
+	}
+	pck.AddInt(int32(len(p.Offers)))
+	for _, o := range p.Offers {
+		o.Encode(&pck)
+	}
+	pck.AddInt(p.OfferId)
+	pck.AddBoolean(p.Seasonal)
+	return pck
+}
@@ -0,0 +1,63 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/catalog/encode"
+	"pixels-emulator/core/protocol"
+)
+
+// TestPagePacket_Serialize verifies the packet serialization.
+func TestPagePacket_Serialize(t *testing.T) {
+	pck := &PagePacket{
+		PageId:  2,
+		Mode:    "NORMAL",
+		Layout:  "default_3x3",
+		Images:  []string{"header", "teaser", ""},
+		Texts:   []string{"Furniture"},
+		Offers:  []encode.Offer{{OfferId: 4, Name: "chair", Credits: 3, Products: []encode.Product{{Type: encode.FloorProduct, Sprite: 13, Count: 1}}}},
+		OfferId: -1,
+	}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	id, _ := raw.ReadInt()
+	mode, _ := raw.ReadString()
+	layout, _ := raw.ReadString()
+	assert.Equal(t, int32(2), id)
+	assert.Equal(t, "NORMAL", mode)
+	assert.Equal(t, "default_3x3", layout)
+
+	for _, l := range [][]string{pck.Images, pck.Texts} {
+		n, _ := raw.ReadInt()
+		assert.Equal(t, int32(len(l)), n)
+		for _, s := range l {
+			v, _ := raw.ReadString()
+			assert.Equal(t, s, v)
+		}
+	}
+
+	n, _ := raw.ReadInt()
+	assert.Equal(t, int32(1), n)
+	offer := encode.Offer{}
+	assert.NoError(t, offer.Decode(raw))
+	assert.Equal(t, pck.Offers[0], offer)
+
+	selected, _ := raw.ReadInt()
+	seasonal, err := raw.ReadBoolean()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(-1), selected)
+	assert.False(t, seasonal)
+}
+
+// TestPagePacket_Integrity tests packet ID, deadline, and rate.
+func TestPagePacket_Integrity(t *testing.T) {
+	pck := &PagePacket{}
+	assert.Equal(t, uint16(PageCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,58 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// PurchaseCode is the unique identifier for the packet
+const PurchaseCode = 3492
+
+// PurchasePacket requests buying an offer of a catalog page.
+type PurchasePacket struct {
+	Page   int32  // Page is the identifier of the page.
+	Offer  int32  // Offer is the identifier of the offer.
+	Extra  string // Extra is the additional data of the purchase.
+	Amount int32  // Amount is the times the offer is bought.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *PurchasePacket) Id() uint16 {
+	return PurchaseCode
+}
+
+// Rate returns the rate limit for the packet. Purchases are limited for each user
+// by the connection rate limiter to a purchase per second, with bursts of five.
+func (p *PurchasePacket) Rate() (uint16, uint16) {
+	return 5, 5
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *PurchasePacket) Deadline() uint {
+	return 500
+}
+
+// ComposePurchasePacket composes a new instance of the packet.
+func ComposePurchasePacket(pck protocol.RawPacket) (*PurchasePacket, error) {
+
+	pa, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &PurchasePacket{Page: pa, Offer: o, Extra: e, Amount: a}, nil
+
+}
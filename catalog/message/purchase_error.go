@@ -0,0 +1,37 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// PurchaseErrorCode is the unique identifier for the packet
+const PurchaseErrorCode = 1404
+
+// ServerError is the failure of purchases not completed by the server.
+const ServerError int32 = 0
+
+// PurchaseErrorPacket notifies a purchase failed on the server.
+type PurchaseErrorPacket struct {
+	Code int32 // Code is the reason of the failure.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *PurchaseErrorPacket) Id() uint16 {
+	return PurchaseErrorCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *PurchaseErrorPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *PurchaseErrorPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *PurchaseErrorPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(PurchaseErrorCode)
+	pck.AddInt(p.Code)
+	return pck
+}
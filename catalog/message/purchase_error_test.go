@@ -0,0 +1,31 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestPurchaseErrorPacket_Serialize verifies the packet serialization.
+func TestPurchaseErrorPacket_Serialize(t *testing.T) {
+	pck := &PurchaseErrorPacket{Code: int32(1)}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(PurchaseErrorCode), raw.GetHeader())
+
+	c0, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), c0)
+}
+
+// TestPurchaseErrorPacket_Integrity tests packet ID, deadline, and rate.
+func TestPurchaseErrorPacket_Integrity(t *testing.T) {
+	pck := &PurchaseErrorPacket{}
+	assert.Equal(t, uint16(PurchaseErrorCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
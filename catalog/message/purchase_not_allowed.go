@@ -0,0 +1,37 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// PurchaseNotAllowedCode is the unique identifier for the packet
+const PurchaseNotAllowedCode = 3770
+
+// IllegalPurchase is the rejection of purchases the user can not make.
+const IllegalPurchase int32 = 0
+
+// PurchaseNotAllowedPacket notifies a purchase the user is not allowed to make.
+type PurchaseNotAllowedPacket struct {
+	Code int32 // Code is the reason of the rejection.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *PurchaseNotAllowedPacket) Id() uint16 {
+	return PurchaseNotAllowedCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *PurchaseNotAllowedPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *PurchaseNotAllowedPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *PurchaseNotAllowedPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(PurchaseNotAllowedCode)
+	pck.AddInt(p.Code)
+	return pck
+}
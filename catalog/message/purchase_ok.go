@@ -0,0 +1,37 @@
+package message
+
+import (
+	"pixels-emulator/catalog/encode"
+	"pixels-emulator/core/protocol"
+)
+
+// PurchaseOKCode is the unique identifier for the packet
+const PurchaseOKCode = 869
+
+// PurchaseOKPacket notifies an offer bought by the user.
+type PurchaseOKPacket struct {
+	Offer encode.Offer // Offer is the bought offer.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *PurchaseOKPacket) Id() uint16 {
+	return PurchaseOKCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *PurchaseOKPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *PurchaseOKPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *PurchaseOKPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(PurchaseOKCode)
+	p.Offer.Encode(&pck)
+	return pck
+}
@@ -0,0 +1,31 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/catalog/encode"
+	"pixels-emulator/core/protocol"
+)
+
+// TestPurchaseOKPacket_Serialize verifies the packet serialization.
+func TestPurchaseOKPacket_Serialize(t *testing.T) {
+	pck := &PurchaseOKPacket{Offer: encode.Offer{OfferId: 4, Name: "chair", Credits: 3, Products: []encode.Product{{Type: encode.FloorProduct, Sprite: 13, Count: 1}}}}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	offer := encode.Offer{}
+	assert.NoError(t, offer.Decode(raw))
+	assert.Equal(t, pck.Offer, offer)
+}
+
+// TestPurchaseOKPacket_Integrity tests packet ID, deadline, and rate.
+func TestPurchaseOKPacket_Integrity(t *testing.T) {
+	pck := &PurchaseOKPacket{}
+	assert.Equal(t, uint16(PurchaseOKCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,37 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposePurchasePacket verifies the packet is composed from its content.
+func TestComposePurchasePacket(t *testing.T) {
+	raw := protocol.NewPacket(PurchaseCode)
+	raw.AddInt(int32(1))
+	raw.AddInt(int32(2))
+	raw.AddString("value3")
+	raw.AddInt(int32(4))
+
+	pck, err := ComposePurchasePacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.Page)
+	assert.Equal(t, int32(2), pck.Offer)
+	assert.Equal(t, "value3", pck.Extra)
+	assert.Equal(t, int32(4), pck.Amount)
+
+	_, err = ComposePurchasePacket(protocol.NewPacket(PurchaseCode))
+	assert.Error(t, err)
+}
+
+// TestPurchasePacket_Integrity tests packet ID, deadline, and rate.
+func TestPurchasePacket_Integrity(t *testing.T) {
+	pck := &PurchasePacket{}
+	assert.Equal(t, uint16(PurchaseCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(5), mn)
+	assert.Equal(t, uint16(5), mx)
+}
@@ -0,0 +1,32 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// SoldOutCode is the unique identifier for the packet
+const SoldOutCode = 377
+
+// SoldOutPacket notifies a limited offer has no stock left.
+type SoldOutPacket struct {
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *SoldOutPacket) Id() uint16 {
+	return SoldOutCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *SoldOutPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *SoldOutPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *SoldOutPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(SoldOutCode)
+	return pck
+}
@@ -0,0 +1,27 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestSoldOutPacket_Serialize verifies the packet serialization.
+func TestSoldOutPacket_Serialize(t *testing.T) {
+	pck := &SoldOutPacket{}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(SoldOutCode), raw.GetHeader())
+}
+
+// TestSoldOutPacket_Integrity tests packet ID, deadline, and rate.
+func TestSoldOutPacket_Integrity(t *testing.T) {
+	pck := &SoldOutPacket{}
+	assert.Equal(t, uint16(SoldOutCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
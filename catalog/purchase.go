@@ -0,0 +1,199 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"pixels-emulator/core/model"
+	"pixels-emulator/user/message"
+)
+
+// MaxPurchaseAmount defines the times an offer can be bought at once.
+const MaxPurchaseAmount = 100
+
+const (
+	Duckets = 0 // Duckets is the activity points currency of the user duckets.
+	Pixels  = 5 // Pixels is the activity points currency of the user pixels.
+)
+
+var (
+	ErrOfferNotFound    = errors.New("catalog offer not found")          // ErrOfferNotFound rejects offers not listed on the page.
+	ErrPurchaseAmount   = errors.New("invalid purchase amount")          // ErrPurchaseAmount rejects amounts the offer can not be bought.
+	ErrNotEnoughBalance = errors.New("not enough balance")               // ErrNotEnoughBalance rejects purchases over the user balance.
+	ErrUnknownCurrency  = errors.New("unknown activity points currency") // ErrUnknownCurrency rejects offers charged in currencies not kept.
+	ErrSoldOut          = errors.New("limited offer sold out")           // ErrSoldOut rejects limited offers without stock left.
+	ErrEmptyOffer       = errors.New("offer without items")              // ErrEmptyOffer rejects offers not giving any item.
+)
+
+// columns are the user balances of each activity points currency.
+var columns = map[int]string{
+	Duckets: "duckets",
+	Pixels:  "pixels",
+}
+
+// Cost provides the credits and activity points charged for buying an offer many times.
+func Cost(o *model.CatalogOffer, amount int) (int, int) {
+	return o.CostCredits * amount, o.CostPoints * amount
+}
+
+// ValidAmount checks an offer can be bought many times at once.
+// Limited offers and offers not allowing bulk purchases are bought once.
+func ValidAmount(o *model.CatalogOffer, amount int) error {
+	if amount < 1 || amount > MaxPurchaseAmount {
+		return ErrPurchaseAmount
+	}
+	if amount > 1 && (!o.BulkAllowed || o.LimitedStack > 0) {
+		return ErrPurchaseAmount
+	}
+	return nil
+}
+
+// Balance provides the user balance of an activity points currency.
+func Balance(u model.User, currency int) int {
+	switch currency {
+	case Duckets:
+		return u.Duckets
+	case Pixels:
+		return u.Pixels
+	}
+	return 0
+}
+
+// Currencies provides the protocol version of the user activity points balances.
+func Currencies(u model.User) []message.Currency {
+	return []message.Currency{
+		{Type: Duckets, Amount: int32(u.Duckets)},
+		{Type: Pixels, Amount: int32(u.Pixels)},
+	}
+}
+
+// Affordable checks if the user has enough credits and activity points to buy an offer many times.
+func Affordable(u model.User, o *model.CatalogOffer, amount int) (bool, bool) {
+	credits, points := Cost(o, amount)
+	return u.Credits >= credits, points == 0 || Balance(u, o.PointsType) >= points
+}
+
+// Items provides the inventory items given to a user by buying an offer many times, not yet persisted.
+func Items(u model.User, o *model.CatalogOffer, amount int) []*model.InventoryItem {
+	var items []*model.InventoryItem
+	for n := 0; n < amount; n++ {
+		for _, it := range o.Items {
+			for c := 0; c < it.Amount; c++ {
+				items = append(items, &model.InventoryItem{
+					UserID:       u.ID,
+					User:         u,
+					DefinitionID: it.DefinitionID,
+					Definition:   it.Definition,
+					ExtraData:    it.ExtraData,
+				})
+			}
+		}
+	}
+	return items
+}
+
+// Purchase buys an offer many times for a user. The balance is debited, the limited
+// stock taken and the inventory items created in a single transaction, so the purchase
+// is rejected as a whole if the balance or the stock are not enough anymore.
+// Limited offers are sold one at a time, giving their items the next serial number
+// and updating the sells of the offer.
+func Purchase(ctx context.Context, db *gorm.DB, u model.User, o *model.CatalogOffer, amount int) ([]*model.InventoryItem, error) {
+
+	if err := ValidAmount(o, amount); err != nil {
+		return nil, err
+	}
+
+	credits, points := Cost(o, amount)
+	column, ok := columns[o.PointsType]
+	if points > 0 && !ok {
+		return nil, ErrUnknownCurrency
+	}
+
+	items := Items(u, o, amount)
+	if len(items) == 0 {
+		return nil, ErrEmptyOffer
+	}
+
+	serial := 0
+	purchase := func() error {
+		return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+
+			if err := debit(tx, u, column, credits, points); err != nil {
+				return err
+			}
+
+			if o.LimitedStack > 0 {
+				var err error
+				if serial, err = takeSerial(tx, o); err != nil {
+					return err
+				}
+				for _, i := range items {
+					i.LimitedNumber, i.LimitedStack = serial, o.LimitedStack
+				}
+			}
+
+			return tx.Omit(clause.Associations).Create(&items).Error
+
+		})
+	}
+
+	var err error
+	if o.LimitedStack > 0 {
+		err = limited.Sell(o.ID, purchase)
+	} else {
+		err = purchase()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if serial > 0 {
+		o.LimitedSells = serial
+	}
+	return items, nil
+
+}
+
+// Charge debits the cost of an offer bought once along the transaction of what it gives,
+// so nothing is given if the balance is not enough anymore.
+func Charge(tx *gorm.DB, u model.User, o *model.CatalogOffer) error {
+
+	credits, points := Cost(o, 1)
+	column, ok := columns[o.PointsType]
+	if points > 0 && !ok {
+		return ErrUnknownCurrency
+	}
+
+	return debit(tx, u, column, credits, points)
+
+}
+
+// debit takes the credits and activity points from the user balance, only if enough.
+func debit(tx *gorm.DB, u model.User, column string, credits, points int) error {
+
+	if credits <= 0 && points <= 0 {
+		return nil
+	}
+
+	q := tx.Model(&model.User{}).Where("id = ?", u.ID)
+	update := make(map[string]interface{})
+	if credits > 0 {
+		q = q.Where("credits >= ?", credits)
+		update["credits"] = gorm.Expr("credits - ?", credits)
+	}
+	if points > 0 {
+		q = q.Where(column+" >= ?", points)
+		update[column] = gorm.Expr(column+" - ?", points)
+	}
+
+	res := q.Updates(update)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrNotEnoughBalance
+	}
+	return nil
+
+}
@@ -0,0 +1,102 @@
+package catalog
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"pixels-emulator/core/model"
+)
+
+// offer creates an offer giving an amount of a kind of furniture.
+func offer(credits, points, currency, amount int) *model.CatalogOffer {
+	return &model.CatalogOffer{CostCredits: credits, CostPoints: points, PointsType: currency, BulkAllowed: true, Items: []model.CatalogOfferItem{
+		{DefinitionID: 2, Definition: model.ItemDefinition{SpriteID: 13}, Amount: amount, ExtraData: "0"},
+	}}
+}
+
+// TestValidAmount verifies the bulk purchases are limited.
+func TestValidAmount(t *testing.T) {
+	o := offer(1, 0, Duckets, 1)
+	assert.NoError(t, ValidAmount(o, 1))
+	assert.NoError(t, ValidAmount(o, MaxPurchaseAmount))
+	assert.ErrorIs(t, ValidAmount(o, 0), ErrPurchaseAmount)
+	assert.ErrorIs(t, ValidAmount(o, MaxPurchaseAmount+1), ErrPurchaseAmount)
+
+	o.BulkAllowed = false
+	assert.ErrorIs(t, ValidAmount(o, 2), ErrPurchaseAmount)
+
+	o.BulkAllowed, o.LimitedStack = true, 10
+	assert.ErrorIs(t, ValidAmount(o, 2), ErrPurchaseAmount, "limited offers are bought once")
+}
+
+// TestAffordable verifies the balance is checked for each charged currency.
+func TestAffordable(t *testing.T) {
+	u := model.User{Credits: 10, Duckets: 5, Pixels: 1}
+
+	credits, points := Affordable(u, offer(5, 5, Duckets, 1), 2)
+	assert.True(t, credits)
+	assert.False(t, points)
+
+	credits, points = Affordable(u, offer(6, 1, Pixels, 1), 2)
+	assert.False(t, credits)
+	assert.False(t, points)
+
+	credits, points = Affordable(u, offer(0, 0, Pixels, 1), 100)
+	assert.True(t, credits)
+	assert.True(t, points)
+}
+
+// TestItems verifies the items of every bought offer are given to the user.
+func TestItems(t *testing.T) {
+	u := model.User{Username: "john"}
+	u.ID = 3
+
+	items := Items(u, offer(1, 0, Duckets, 2), 3)
+	assert.Len(t, items, 6)
+	for _, i := range items {
+		assert.Equal(t, uint(3), i.UserID)
+		assert.Equal(t, "john", i.User.Username)
+		assert.Equal(t, uint(2), i.DefinitionID)
+		assert.Equal(t, "0", i.ExtraData)
+	}
+}
+
+// TestPurchase_Rejected verifies invalid purchases are rejected before touching the database.
+func TestPurchase_Rejected(t *testing.T) {
+	db, err := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+	assert.NoError(t, err)
+
+	_, err = Purchase(context.Background(), db, model.User{}, offer(1, 0, Duckets, 1), 0)
+	assert.ErrorIs(t, err, ErrPurchaseAmount)
+
+	_, err = Purchase(context.Background(), db, model.User{}, offer(1, 1, 3, 1), 1)
+	assert.ErrorIs(t, err, ErrUnknownCurrency)
+
+	_, err = Purchase(context.Background(), db, model.User{}, &model.CatalogOffer{CostCredits: 1}, 1)
+	assert.ErrorIs(t, err, ErrEmptyOffer)
+}
+
+// TestCharge verifies only the offers with a cost debit the balance, when enough.
+func TestCharge(t *testing.T) {
+	db, err := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+	assert.NoError(t, err)
+
+	var sql []string
+	assert.NoError(t, db.Callback().Update().After("gorm:update").Register("test:capture", func(tx *gorm.DB) {
+		sql = append(sql, tx.Statement.SQL.String())
+	}))
+
+	assert.NoError(t, Charge(db, model.User{}, offer(0, 0, Duckets, 1)))
+	assert.Empty(t, sql, "free offers are not debited")
+
+	assert.ErrorIs(t, Charge(db, model.User{}, offer(0, 5, 3, 1)), ErrUnknownCurrency)
+
+	// Dry runs affect no rows, as a balance which is not enough anymore.
+	assert.ErrorIs(t, Charge(db, model.User{}, offer(10, 5, Pixels, 1)), ErrNotEnoughBalance)
+	assert.Len(t, sql, 1)
+	assert.Contains(t, sql[0], "credits >= ?")
+	assert.Contains(t, sql[0], "pixels >= ?")
+}
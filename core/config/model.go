@@ -26,10 +26,33 @@ type LoggingConfig struct {
 	Level        string `mapstructure:"level" default:"INFO"`         // Level of the logging.
 }
 
+// RoomConfig holds the configuration of the in-game rooms.
+type RoomConfig struct {
+	CycleWorkers uint16 `mapstructure:"cycle_workers" default:"4"` // CycleWorkers defines the amount of workers processing room cycles.
+	UnloadGrace  uint16 `mapstructure:"unload_grace" default:"60"` // UnloadGrace defines the seconds an empty room stays loaded.
+	MaxUsers     uint16 `mapstructure:"max_users" default:"50"`    // MaxUsers defines the highest users limit an owner can set.
+	MaxRooms     uint16 `mapstructure:"max_rooms" default:"25"`    // MaxRooms defines the amount of rooms a player can own.
+}
+
+// ChatConfig holds the configuration of the room chat.
+type ChatConfig struct {
+	LogBatch     uint16 `mapstructure:"log_batch" default:"50"`     // LogBatch defines the amount of chat logs written at once.
+	LogFlush     uint16 `mapstructure:"log_flush" default:"10"`     // LogFlush defines every how many seconds pending chat logs are written.
+	LogRetention uint16 `mapstructure:"log_retention" default:"30"` // LogRetention defines the days chat logs are kept. If 0 logs are never purged.
+}
+
+// InventoryConfig holds the configuration of the user inventories.
+type InventoryConfig struct {
+	MaxItems uint16 `mapstructure:"max_items" default:"5000"` // MaxItems defines the amount of items a user can keep. If 0 inventories are unlimited.
+}
+
 // Config defines the complete model of configuration to
 // be unmarshalled by a configuration provider.
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server" default:""`   // Server base configuration.
-	Database DatabaseConfig `mapstructure:"database" default:""` // Database connection configuration.
-	Logging  LoggingConfig  `mapstructure:"logging" default:""`  // Logging configuration.
+	Server    ServerConfig    `mapstructure:"server" default:""`    // Server base configuration.
+	Database  DatabaseConfig  `mapstructure:"database" default:""`  // Database connection configuration.
+	Logging   LoggingConfig   `mapstructure:"logging" default:""`   // Logging configuration.
+	Room      RoomConfig      `mapstructure:"room" default:""`      // Room configuration.
+	Chat      ChatConfig      `mapstructure:"chat" default:""`      // Chat configuration.
+	Inventory InventoryConfig `mapstructure:"inventory" default:""` // Inventory configuration.
 }
@@ -4,7 +4,7 @@ package cycle
 // amount of ticks, (e.g: A room):
 type Cycleable interface {
 	Cycle()       // Cycle performs the cycle task
-	Time() byte   // Time provides the time of every cycle
+	Time() byte   // Time provides the interval of every cycle in TimeUnit.
 	Stamp() int64 // Stamp provides the last timestamp of cycle.
 	SetStamp()    // SetStamp updates a new timestamp.
 }
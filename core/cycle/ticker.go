@@ -0,0 +1,212 @@
+package cycle
+
+import (
+	"go.uber.org/zap"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// TimeUnit defines the duration of every unit provided by Cycleable.Time.
+const TimeUnit = 100 * time.Millisecond
+
+// Resolution defines how often the ticker checks for elements pending to cycle.
+const Resolution = 50 * time.Millisecond
+
+// Ticker drives the cycles of every registered element at its own interval.
+type Ticker interface {
+	// Register starts cycling an element with the given identifier.
+	Register(id string, c Cycleable)
+
+	// Unregister stops cycling the element with the given identifier.
+	Unregister(id string)
+
+	// Registered checks if an element is being cycled.
+	Registered(id string) bool
+
+	// Duration provides the time the last cycle of an element took.
+	Duration(id string) (time.Duration, bool)
+
+	// Start starts the ticking and the worker pool.
+	Start()
+
+	// Stop stops the ticking and waits for the running cycles.
+	Stop()
+}
+
+// entry wraps a registered element with its ticking state.
+type entry struct {
+	id       string       // id is the identifier of the element.
+	c        Cycleable    // c is the element to cycle.
+	busy     atomic.Bool  // busy defines if the element cycle is being processed.
+	duration atomic.Int64 // duration stores the nanoseconds of the last cycle.
+}
+
+// PoolTicker implements Ticker processing cycles on a bounded worker pool.
+type PoolTicker struct {
+	entries map[string]*entry // entries are the registered elements.
+	mu      sync.RWMutex      // mu guards the entries map.
+	jobs    chan *entry       // jobs are the cycles pending to be processed by workers.
+	workers int               // workers defines the size of the pool.
+	stop    chan struct{}     // stop signals the ticking to end.
+	wg      sync.WaitGroup    // wg waits for the pool to finish.
+	running atomic.Bool       // running defines if the ticker is started.
+	logger  *zap.Logger       // logger to log slow or failing cycles.
+}
+
+// Register starts cycling an element with the given identifier.
+func (t *PoolTicker) Register(id string, c Cycleable) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.entries[id]; ok {
+		return
+	}
+	c.SetStamp()
+	t.entries[id] = &entry{id: id, c: c}
+}
+
+// Unregister stops cycling the element with the given identifier.
+func (t *PoolTicker) Unregister(id string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.entries, id)
+}
+
+// Registered checks if an element is being cycled.
+func (t *PoolTicker) Registered(id string) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	_, ok := t.entries[id]
+	return ok
+}
+
+// Duration provides the time the last cycle of an element took.
+func (t *PoolTicker) Duration(id string) (time.Duration, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	e, ok := t.entries[id]
+	if !ok {
+		return 0, false
+	}
+	return time.Duration(e.duration.Load()), true
+}
+
+// Start starts the ticking and the worker pool.
+func (t *PoolTicker) Start() {
+
+	if !t.running.CompareAndSwap(false, true) {
+		return
+	}
+
+	t.stop = make(chan struct{})
+	t.jobs = make(chan *entry, t.workers)
+
+	for i := 0; i < t.workers; i++ {
+		t.wg.Add(1)
+		go t.work()
+	}
+
+	t.wg.Add(1)
+	go t.pulse()
+
+}
+
+// Stop stops the ticking and waits for the running cycles.
+func (t *PoolTicker) Stop() {
+	if !t.running.CompareAndSwap(true, false) {
+		return
+	}
+	close(t.stop)
+	t.wg.Wait()
+}
+
+// pulse dispatches the elements whose interval elapsed to the worker pool.
+func (t *PoolTicker) pulse() {
+
+	defer t.wg.Done()
+	defer close(t.jobs)
+
+	tk := time.NewTicker(Resolution)
+	defer tk.Stop()
+
+	for {
+		select {
+		case <-t.stop:
+			return
+		case now := <-tk.C:
+			t.dispatch(now)
+		}
+	}
+
+}
+
+// dispatch sends the due elements to the worker pool. Elements which cannot be
+// enqueued because the pool is saturated are retried on the next pulse.
+func (t *PoolTicker) dispatch(now time.Time) {
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	for _, e := range t.entries {
+
+		interval := time.Duration(e.c.Time()) * TimeUnit
+		if interval <= 0 || now.UnixMilli()-e.c.Stamp() < interval.Milliseconds() {
+			continue
+		}
+
+		if !e.busy.CompareAndSwap(false, true) {
+			continue
+		}
+
+		select {
+		case t.jobs <- e:
+		default:
+			e.busy.Store(false)
+		}
+
+	}
+
+}
+
+// work processes the cycles dispatched to the pool.
+func (t *PoolTicker) work() {
+	defer t.wg.Done()
+	for e := range t.jobs {
+		t.cycle(e)
+	}
+}
+
+// cycle performs an element cycle recording its duration.
+func (t *PoolTicker) cycle(e *entry) {
+
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			t.logger.Error("Cycle panicked", zap.String("identifier", e.id), zap.Any("reason", r))
+		}
+
+		d := time.Since(start)
+		e.duration.Store(int64(d))
+		e.busy.Store(false)
+
+		if interval := time.Duration(e.c.Time()) * TimeUnit; d > interval {
+			t.logger.Warn("Cycle took longer than its interval", zap.String("identifier", e.id), zap.Duration("duration", d))
+		}
+	}()
+
+	e.c.SetStamp()
+	e.c.Cycle()
+
+}
+
+// NewPoolTicker creates a ticker with a pool of the given amount of workers.
+func NewPoolTicker(workers int, logger *zap.Logger) Ticker {
+	if workers <= 0 {
+		workers = 1
+	}
+	return &PoolTicker{
+		entries: make(map[string]*entry),
+		workers: workers,
+		logger:  logger,
+	}
+}
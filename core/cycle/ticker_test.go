@@ -0,0 +1,163 @@
+package cycle
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/util"
+)
+
+// testCycleable is a demo element counting its cycles.
+type testCycleable struct {
+	cycles atomic.Int32
+	stamp  atomic.Int64
+	time   byte
+	wait   time.Duration
+	panics bool
+}
+
+func (c *testCycleable) Cycle() {
+	c.cycles.Add(1)
+	time.Sleep(c.wait)
+	if c.panics {
+		panic("cycle failure")
+	}
+}
+
+func (c *testCycleable) Time() byte {
+	return c.time
+}
+
+func (c *testCycleable) Stamp() int64 {
+	return c.stamp.Load()
+}
+
+func (c *testCycleable) SetStamp() {
+	c.stamp.Store(time.Now().UnixMilli())
+}
+
+func newTestTicker(workers int) Ticker {
+	logger, _ := util.CreateTestLogger()
+	return NewPoolTicker(workers, logger)
+}
+
+// TestPoolTicker_Cycle verifies registered elements are cycled and durations recorded.
+func TestPoolTicker_Cycle(t *testing.T) {
+	tk := newTestTicker(2)
+	c := &testCycleable{time: 1, wait: 5 * time.Millisecond}
+
+	tk.Register("1", c)
+	assert.True(t, tk.Registered("1"))
+
+	tk.Start()
+	defer tk.Stop()
+
+	assert.Eventually(t, func() bool { return c.cycles.Load() >= 2 }, 2*time.Second, 10*time.Millisecond)
+
+	d, ok := tk.Duration("1")
+	assert.True(t, ok)
+	assert.GreaterOrEqual(t, d, 5*time.Millisecond)
+}
+
+// TestPoolTicker_Unregister verifies unregistered elements are not cycled anymore.
+func TestPoolTicker_Unregister(t *testing.T) {
+	tk := newTestTicker(1)
+	c := &testCycleable{time: 1}
+
+	tk.Register("1", c)
+	tk.Start()
+	defer tk.Stop()
+
+	assert.Eventually(t, func() bool { return c.cycles.Load() >= 1 }, 2*time.Second, 10*time.Millisecond)
+	tk.Unregister("1")
+	assert.False(t, tk.Registered("1"))
+
+	_, ok := tk.Duration("1")
+	assert.False(t, ok)
+
+	time.Sleep(2 * TimeUnit)
+	count := c.cycles.Load()
+	time.Sleep(3 * TimeUnit)
+	assert.Equal(t, count, c.cycles.Load())
+}
+
+// TestPoolTicker_Interval verifies elements are not cycled before their interval.
+func TestPoolTicker_Interval(t *testing.T) {
+	tk := newTestTicker(1)
+	c := &testCycleable{time: 50}
+
+	tk.Register("1", c)
+	tk.Start()
+	defer tk.Stop()
+
+	time.Sleep(4 * Resolution)
+	assert.Equal(t, int32(0), c.cycles.Load())
+}
+
+// TestPoolTicker_Panic verifies a failing cycle does not stop the ticker.
+func TestPoolTicker_Panic(t *testing.T) {
+	tk := newTestTicker(1)
+	c := &testCycleable{time: 1, panics: true}
+
+	tk.Register("1", c)
+	tk.Start()
+	defer tk.Stop()
+
+	assert.Eventually(t, func() bool { return c.cycles.Load() >= 2 }, 2*time.Second, 10*time.Millisecond)
+}
+
+// TestPoolTicker_Bounded verifies cycles never exceed the worker pool size.
+func TestPoolTicker_Bounded(t *testing.T) {
+	tk := newTestTicker(2)
+
+	var mu sync.Mutex
+	var running, peak int
+	for i := 0; i < 6; i++ {
+		c := &boundedCycleable{testCycleable: testCycleable{time: 1}, before: func() {
+			mu.Lock()
+			running++
+			if running > peak {
+				peak = running
+			}
+			mu.Unlock()
+		}, after: func() {
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}}
+		tk.Register(string(rune('a'+i)), c)
+	}
+
+	tk.Start()
+	time.Sleep(5 * TimeUnit)
+	tk.Stop()
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.LessOrEqual(t, peak, 2)
+	assert.Greater(t, peak, 0)
+}
+
+// boundedCycleable tracks concurrent cycles.
+type boundedCycleable struct {
+	testCycleable
+	before, after func()
+}
+
+func (c *boundedCycleable) Cycle() {
+	c.before()
+	time.Sleep(20 * time.Millisecond)
+	c.after()
+}
+
+// TestPoolTicker_StartStop verifies the ticker can be safely started and stopped twice.
+func TestPoolTicker_StartStop(t *testing.T) {
+	tk := newTestTicker(0)
+	tk.Start()
+	tk.Start()
+	tk.Stop()
+	tk.Stop()
+}
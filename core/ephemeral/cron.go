@@ -2,10 +2,15 @@ package ephemeral
 
 import (
 	healthcheck "pixels-emulator/healthcheck/scheduler"
+	roomScheduler "pixels-emulator/room/scheduler"
 )
 
 func Cron() {
 
 	healthcheck.SchedulePing()
+	roomScheduler.ScheduleChatLog()
+	roomScheduler.ScheduleUnload()
+	roomScheduler.ScheduleRestrictionPurge()
+	roomScheduler.SchedulePromotionExpiry()
 
 }
@@ -4,6 +4,7 @@ import (
 	authEvent "pixels-emulator/auth/event"
 	authListener "pixels-emulator/auth/grant"
 	"pixels-emulator/core/server"
+	inventoryListener "pixels-emulator/inventory/listener"
 	navEvent "pixels-emulator/navigator/event"
 	navListener "pixels-emulator/navigator/listener"
 	roomEvent "pixels-emulator/room/event"
@@ -13,8 +14,14 @@ import (
 func Event() {
 	em := server.GetServer().EventManager()
 	em.AddListener(authEvent.AuthGrantEventName, authListener.ProvideAuth(), 10)
+	em.AddListener(authEvent.AuthGrantEventName, roomListener.ProvideFlatCategories(), 5)
+	em.AddListener(authEvent.AuthGrantEventName, inventoryListener.ProvideEffects(), 5)
 	em.AddListener(navEvent.NavigatorQueryEventName, navListener.ProvideSearch(), 10)
 	em.AddListener(roomEvent.RoomJoinEventName, roomListener.ProvideUserJoin(), 10)
 	em.AddListener(roomEvent.RoomLoadRequestEventName, roomListener.ProvideRoomLoadRequest(), 10)
+	em.AddListener(roomEvent.RoomEnterEventName, roomListener.ProvideRoomEnter(), 10)
+	em.AddListener(roomEvent.RoomDoorbellEventName, roomListener.ProvideRoomDoorbell(), 10)
 	em.AddListener(roomEvent.RoomCloseConnectionEventName, roomListener.ProvideRoomClose(), 10)
+	em.AddListener(roomEvent.RoomChatEventName, roomListener.ProvideRoomChat(), 10)
+	em.AddListener(roomEvent.RoomChatEventName, roomListener.ProvideChatLog(), 5)
 }
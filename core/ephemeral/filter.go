@@ -0,0 +1,10 @@
+package ephemeral
+
+import (
+	"pixels-emulator/core/server"
+	navFilter "pixels-emulator/navigator/filter"
+)
+
+func Filters() {
+	navFilter.RegisterBuiltin(server.GetServer().RoomStore())
+}
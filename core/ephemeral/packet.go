@@ -3,15 +3,31 @@ package ephemeral
 import (
 	authHandler "pixels-emulator/auth/handler"
 	authMsg "pixels-emulator/auth/message"
+	catalogHandler "pixels-emulator/catalog/handler"
+	catalogMsg "pixels-emulator/catalog/message"
 	"pixels-emulator/core/protocol"
 	"pixels-emulator/core/server"
 	healthHandler "pixels-emulator/healthcheck/handler"
 	healthMsg "pixels-emulator/healthcheck/message"
+	inventoryHandler "pixels-emulator/inventory/handler"
+	inventoryMsg "pixels-emulator/inventory/message"
 	navigatorHandler "pixels-emulator/navigator/handler"
 	navigatorMsg "pixels-emulator/navigator/message"
 	roomHandler "pixels-emulator/room/handler"
 	roomMsg "pixels-emulator/room/message"
+	categoryRoomMsg "pixels-emulator/room/message/category"
+	chatRoomMsg "pixels-emulator/room/message/chat"
+	creationRoomMsg "pixels-emulator/room/message/creation"
+	doorbellRoomMsg "pixels-emulator/room/message/doorbell"
+	floorPlanRoomMsg "pixels-emulator/room/message/floorplan"
 	guestRoomMsg "pixels-emulator/room/message/guest"
+	itemRoomMsg "pixels-emulator/room/message/item"
+	promotionRoomMsg "pixels-emulator/room/message/promotion"
+	restrictionRoomMsg "pixels-emulator/room/message/restriction"
+	rightsRoomMsg "pixels-emulator/room/message/rights"
+	scoreRoomMsg "pixels-emulator/room/message/score"
+	settingsRoomMsg "pixels-emulator/room/message/settings"
+	unitRoomMsg "pixels-emulator/room/message/unit"
 )
 
 // Processors generates all the raw packet processing.
@@ -36,16 +52,147 @@ func Processors() {
 	pReg.Register(navigatorMsg.NavigatorSearchCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
 		return navigatorMsg.ComposeNavigatorSearch(raw)
 	})
+	pReg.Register(navigatorMsg.NavigatorSaveSearchCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return navigatorMsg.ComposeNavigatorSaveSearchPacket(raw)
+	})
+	pReg.Register(navigatorMsg.NavigatorDeleteSearchCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return navigatorMsg.ComposeNavigatorDeleteSearchPacket(raw)
+	})
+	pReg.Register(navigatorMsg.NavigatorSaveSettingsCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return navigatorMsg.ComposeNavigatorSaveSettingsPacket(raw)
+	})
+	pReg.Register(navigatorMsg.NavigatorCollapseCategoryCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return navigatorMsg.ComposeNavigatorCollapseCategoryPacket(raw)
+	})
+	pReg.Register(navigatorMsg.NavigatorExpandCategoryCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return navigatorMsg.ComposeNavigatorExpandCategoryPacket(raw)
+	})
+	pReg.Register(navigatorMsg.NavigatorCategoryListModeCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return navigatorMsg.ComposeNavigatorCategoryListModePacket(raw)
+	})
+	pReg.Register(navigatorMsg.NavigatorGetPopularTagsCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return navigatorMsg.ComposeNavigatorGetPopularTagsPacket(raw)
+	})
 
 	pReg.Register(roomMsg.RoomEnterCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
 		return roomMsg.ComposeRoomEnterPacket(raw)
 	})
+	pReg.Register(roomMsg.RoomQuitCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return roomMsg.ComposeRoomQuitPacket(raw)
+	})
 	pReg.Register(roomMsg.RoomFurnitureAliasCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
 		return roomMsg.ComposeRoomFurnitureAliasPacket(raw)
 	})
 	pReg.Register(guestRoomMsg.GetGuestRoomCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
 		return guestRoomMsg.ComposeGuestRoomPacket(raw)
 	})
+	pReg.Register(unitRoomMsg.WalkCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return unitRoomMsg.ComposeWalkPacket(raw)
+	})
+	pReg.Register(chatRoomMsg.TalkCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return chatRoomMsg.ComposeTalkPacket(raw)
+	})
+	pReg.Register(chatRoomMsg.ShoutCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return chatRoomMsg.ComposeShoutPacket(raw)
+	})
+	pReg.Register(chatRoomMsg.WhisperCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return chatRoomMsg.ComposeWhisperPacket(raw)
+	})
+	pReg.Register(doorbellRoomMsg.AnswerCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return doorbellRoomMsg.ComposeAnswerPacket(raw)
+	})
+	pReg.Register(restrictionRoomMsg.BanCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return restrictionRoomMsg.ComposeBanPacket(raw)
+	})
+	pReg.Register(restrictionRoomMsg.UnbanCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return restrictionRoomMsg.ComposeUnbanPacket(raw)
+	})
+	pReg.Register(restrictionRoomMsg.MuteCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return restrictionRoomMsg.ComposeMutePacket(raw)
+	})
+	pReg.Register(restrictionRoomMsg.KickCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return restrictionRoomMsg.ComposeKickPacket(raw)
+	})
+	pReg.Register(restrictionRoomMsg.BanListRequestCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return restrictionRoomMsg.ComposeBanListRequestPacket(raw)
+	})
+	pReg.Register(rightsRoomMsg.GiveRightsCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return rightsRoomMsg.ComposeGiveRightsPacket(raw)
+	})
+	pReg.Register(rightsRoomMsg.RemoveRightsCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return rightsRoomMsg.ComposeRemoveRightsPacket(raw)
+	})
+	pReg.Register(rightsRoomMsg.RemoveAllRightsCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return rightsRoomMsg.ComposeRemoveAllRightsPacket(raw)
+	})
+	pReg.Register(rightsRoomMsg.RightsListRequestCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return rightsRoomMsg.ComposeRightsListRequestPacket(raw)
+	})
+	pReg.Register(settingsRoomMsg.GetSettingsCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return settingsRoomMsg.ComposeGetSettingsPacket(raw)
+	})
+	pReg.Register(settingsRoomMsg.SaveSettingsCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return settingsRoomMsg.ComposeSaveSettingsPacket(raw)
+	})
+	pReg.Register(creationRoomMsg.CanCreateRoomCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return creationRoomMsg.ComposeCanCreateRoomPacket(raw)
+	})
+	pReg.Register(creationRoomMsg.CreateRoomCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return creationRoomMsg.ComposeCreateRoomPacket(raw)
+	})
+	pReg.Register(floorPlanRoomMsg.GetEntryTileCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return floorPlanRoomMsg.ComposeGetEntryTilePacket(raw)
+	})
+	pReg.Register(floorPlanRoomMsg.GetOccupiedTilesCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return floorPlanRoomMsg.ComposeGetOccupiedTilesPacket(raw)
+	})
+	pReg.Register(floorPlanRoomMsg.SaveFloorPlanCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return floorPlanRoomMsg.ComposeSaveFloorPlanPacket(raw)
+	})
+	pReg.Register(scoreRoomMsg.RateCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return scoreRoomMsg.ComposeRatePacket(raw)
+	})
+	pReg.Register(categoryRoomMsg.GetFlatCategoriesCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return categoryRoomMsg.ComposeGetFlatCategoriesPacket(raw)
+	})
+	pReg.Register(promotionRoomMsg.PurchaseRoomAdCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return promotionRoomMsg.ComposePurchaseRoomAdPacket(raw)
+	})
+	pReg.Register(promotionRoomMsg.EditRoomEventCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return promotionRoomMsg.ComposeEditRoomEventPacket(raw)
+	})
+	pReg.Register(itemRoomMsg.PlaceItemCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return itemRoomMsg.ComposePlaceItemPacket(raw)
+	})
+	pReg.Register(itemRoomMsg.MoveFloorItemCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return itemRoomMsg.ComposeMoveFloorItemPacket(raw)
+	})
+	pReg.Register(itemRoomMsg.MoveWallItemCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return itemRoomMsg.ComposeMoveWallItemPacket(raw)
+	})
+	pReg.Register(itemRoomMsg.PickupItemCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return itemRoomMsg.ComposePickupItemPacket(raw)
+	})
+
+	pReg.Register(inventoryMsg.GetFurnitureCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return inventoryMsg.ComposeGetFurniturePacket(raw)
+	})
+	pReg.Register(inventoryMsg.GetBadgesCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return inventoryMsg.ComposeGetBadgesPacket(raw)
+	})
+	pReg.Register(inventoryMsg.WearBadgesCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return inventoryMsg.ComposeWearBadgesPacket(raw)
+	})
+
+	pReg.Register(catalogMsg.GetIndexCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return catalogMsg.ComposeGetIndexPacket(raw)
+	})
+	pReg.Register(catalogMsg.GetPageCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return catalogMsg.ComposeGetPagePacket(raw)
+	})
+	pReg.Register(catalogMsg.PurchaseCode, func(raw protocol.RawPacket, conn protocol.Connection) (protocol.Packet, error) {
+		return catalogMsg.ComposePurchasePacket(raw)
+	})
 
 }
 
@@ -61,9 +208,54 @@ func Handlers() {
 
 	hReg.Register(navigatorMsg.NavigatorInitCode, navigatorHandler.NewNavigatorInit())
 	hReg.Register(navigatorMsg.NavigatorSearchCode, navigatorHandler.NewNavigatorSearch())
+	hReg.Register(navigatorMsg.NavigatorSaveSearchCode, navigatorHandler.NewNavigatorPreference())
+	hReg.Register(navigatorMsg.NavigatorDeleteSearchCode, navigatorHandler.NewNavigatorPreference())
+	hReg.Register(navigatorMsg.NavigatorSaveSettingsCode, navigatorHandler.NewNavigatorPreference())
+	hReg.Register(navigatorMsg.NavigatorCollapseCategoryCode, navigatorHandler.NewNavigatorPreference())
+	hReg.Register(navigatorMsg.NavigatorExpandCategoryCode, navigatorHandler.NewNavigatorPreference())
+	hReg.Register(navigatorMsg.NavigatorCategoryListModeCode, navigatorHandler.NewNavigatorPreference())
+	hReg.Register(navigatorMsg.NavigatorGetPopularTagsCode, navigatorHandler.NewNavigatorPopularTags())
 
 	hReg.Register(roomMsg.RoomEnterCode, roomHandler.NewRoomEnter())
+	hReg.Register(roomMsg.RoomQuitCode, roomHandler.NewRoomQuit())
 	hReg.Register(roomMsg.RoomFurnitureAliasCode, roomHandler.NewFurnitureRequest())
-	hReg.Register(guestRoomMsg.GetGuestRoomCode, roomHandler.NewNavigatorSearch())
+	hReg.Register(guestRoomMsg.GetGuestRoomCode, roomHandler.NewGetGuestRoom())
+	hReg.Register(unitRoomMsg.WalkCode, roomHandler.NewUnitWalk())
+	hReg.Register(chatRoomMsg.TalkCode, roomHandler.NewChat())
+	hReg.Register(chatRoomMsg.ShoutCode, roomHandler.NewChat())
+	hReg.Register(chatRoomMsg.WhisperCode, roomHandler.NewChat())
+	hReg.Register(doorbellRoomMsg.AnswerCode, roomHandler.NewDoorbellAnswer())
+	hReg.Register(restrictionRoomMsg.BanCode, roomHandler.NewRoomRestriction())
+	hReg.Register(restrictionRoomMsg.UnbanCode, roomHandler.NewRoomRestriction())
+	hReg.Register(restrictionRoomMsg.MuteCode, roomHandler.NewRoomRestriction())
+	hReg.Register(restrictionRoomMsg.KickCode, roomHandler.NewRoomRestriction())
+	hReg.Register(restrictionRoomMsg.BanListRequestCode, roomHandler.NewRoomRestriction())
+	hReg.Register(rightsRoomMsg.GiveRightsCode, roomHandler.NewRoomRights())
+	hReg.Register(rightsRoomMsg.RemoveRightsCode, roomHandler.NewRoomRights())
+	hReg.Register(rightsRoomMsg.RemoveAllRightsCode, roomHandler.NewRoomRights())
+	hReg.Register(rightsRoomMsg.RightsListRequestCode, roomHandler.NewRoomRights())
+	hReg.Register(settingsRoomMsg.GetSettingsCode, roomHandler.NewRoomSettings())
+	hReg.Register(settingsRoomMsg.SaveSettingsCode, roomHandler.NewRoomSettings())
+	hReg.Register(creationRoomMsg.CanCreateRoomCode, roomHandler.NewRoomCreate())
+	hReg.Register(creationRoomMsg.CreateRoomCode, roomHandler.NewRoomCreate())
+	hReg.Register(floorPlanRoomMsg.GetEntryTileCode, roomHandler.NewFloorPlan())
+	hReg.Register(floorPlanRoomMsg.GetOccupiedTilesCode, roomHandler.NewFloorPlan())
+	hReg.Register(floorPlanRoomMsg.SaveFloorPlanCode, roomHandler.NewFloorPlan())
+	hReg.Register(scoreRoomMsg.RateCode, roomHandler.NewRoomRate())
+	hReg.Register(categoryRoomMsg.GetFlatCategoriesCode, roomHandler.NewFlatCategories())
+	hReg.Register(promotionRoomMsg.PurchaseRoomAdCode, roomHandler.NewRoomPromotion())
+	hReg.Register(promotionRoomMsg.EditRoomEventCode, roomHandler.NewRoomPromotion())
+	hReg.Register(itemRoomMsg.PlaceItemCode, roomHandler.NewRoomItem())
+	hReg.Register(itemRoomMsg.MoveFloorItemCode, roomHandler.NewRoomItem())
+	hReg.Register(itemRoomMsg.MoveWallItemCode, roomHandler.NewRoomItem())
+	hReg.Register(itemRoomMsg.PickupItemCode, roomHandler.NewRoomItem())
+
+	hReg.Register(inventoryMsg.GetFurnitureCode, inventoryHandler.NewInventoryFurniture())
+	hReg.Register(inventoryMsg.GetBadgesCode, inventoryHandler.NewInventoryBadges())
+	hReg.Register(inventoryMsg.WearBadgesCode, inventoryHandler.NewInventoryBadges())
+
+	hReg.Register(catalogMsg.GetIndexCode, catalogHandler.NewCatalogIndex())
+	hReg.Register(catalogMsg.GetPageCode, catalogHandler.NewCatalogPage())
+	hReg.Register(catalogMsg.PurchaseCode, catalogHandler.NewCatalogPurchase())
 
 }
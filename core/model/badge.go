@@ -0,0 +1,46 @@
+package model
+
+import (
+	"pixels-emulator/core/database"
+	"time"
+)
+
+// UserBadge represents a badge earned by a user, which can be worn on the profile.
+type UserBadge struct {
+	database.BaseModel
+
+	// UserID is the ID of the owner of the badge. Along Code, prevents duplicate entries.
+	UserID uint `gorm:"not null;index;uniqueIndex:user_badge_unique;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+
+	// User is the owner of the badge.
+	User User `gorm:"foreignKey:UserID"`
+
+	// Code is the identifier of the badge image.
+	Code string `gorm:"type:varchar(50);not null;uniqueIndex:user_badge_unique"`
+
+	// Slot is the profile position where the badge is worn, zero if not worn.
+	Slot int `gorm:"not null;default:0"`
+}
+
+// UserEffect represents an avatar effect kept by a user, enabled for a while on each activation.
+type UserEffect struct {
+	database.BaseModel
+
+	// UserID is the ID of the owner of the effect.
+	UserID uint `gorm:"not null;index;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+
+	// User is the owner of the effect.
+	User User `gorm:"foreignKey:UserID"`
+
+	// Effect is the identifier of the avatar effect.
+	Effect int `gorm:"not null"`
+
+	// Duration are the seconds each activation lasts, permanent if zero.
+	Duration int `gorm:"not null;default:0"`
+
+	// Quantity is the amount of activations left, the running one included.
+	Quantity int `gorm:"not null;default:1"`
+
+	// ActivatedAt is when the running activation started, nil if not activated.
+	ActivatedAt *time.Time
+}
@@ -0,0 +1,108 @@
+package model
+
+import "pixels-emulator/core/database"
+
+// CatalogPage represents a page of the catalog tree, where offers are listed.
+type CatalogPage struct {
+	database.BaseModel
+
+	// ParentID is the ID of the page containing this one, zero for the root pages.
+	ParentID uint `gorm:"not null;default:0;index"`
+
+	// Name is the code of the page used by the client.
+	Name string `gorm:"type:varchar(100);not null"`
+
+	// Caption is the display name of the page.
+	Caption string `gorm:"type:varchar(100);not null"`
+
+	// Icon is the image displayed along the caption.
+	Icon int `gorm:"not null;default:0"`
+
+	// Layout is the client template used to display the page.
+	Layout string `gorm:"type:varchar(50);not null;default:default_3x3"`
+
+	// HeaderImage is the image displayed on top of the page.
+	HeaderImage string `gorm:"type:varchar(255)"`
+
+	// TeaserImage is the image displayed beside the page texts.
+	TeaserImage string `gorm:"type:varchar(255)"`
+
+	// SpecialImage is the additional image used by some layouts.
+	SpecialImage string `gorm:"type:varchar(255)"`
+
+	// HeaderText is the text displayed on top of the page.
+	HeaderText string `gorm:"type:text"`
+
+	// DetailText is the text describing the page.
+	DetailText string `gorm:"type:text"`
+
+	// TeaserText is the text displayed beside the teaser image.
+	TeaserText string `gorm:"type:text"`
+
+	// Visible determines if the page is listed to the players.
+	Visible bool `gorm:"not null;default:true"`
+
+	// Permission is the minimum role permission required to open the page, empty for everyone.
+	Permission string `gorm:"type:varchar(255)"`
+
+	// Priority sorts the pages of the same parent, higher first.
+	Priority int `gorm:"not null;default:0"`
+
+	// Offers are the offers listed on the page.
+	Offers []CatalogOffer `gorm:"foreignKey:PageID"`
+}
+
+// CatalogOffer represents a set of items sold on a catalog page.
+type CatalogOffer struct {
+	database.BaseModel
+
+	// PageID is the ID of the page where the offer is listed.
+	PageID uint `gorm:"not null;index"`
+
+	// Name is the localization code of the offer.
+	Name string `gorm:"type:varchar(100);not null"`
+
+	// CostCredits is the amount of credits charged by the offer.
+	CostCredits int `gorm:"not null;default:0"`
+
+	// CostPoints is the amount of activity points charged by the offer.
+	CostPoints int `gorm:"not null;default:0"`
+
+	// PointsType is the currency of the activity points charged.
+	PointsType int `gorm:"not null;default:0"`
+
+	// BulkAllowed determines if the offer can be bought many times at once.
+	BulkAllowed bool `gorm:"not null;default:true"`
+
+	// LimitedStack is the amount of times the offer can be sold, unlimited if zero.
+	LimitedStack int `gorm:"not null;default:0"`
+
+	// LimitedSells is the amount of times the limited offer was sold.
+	LimitedSells int `gorm:"not null;default:0"`
+
+	// Priority sorts the offers of the same page, higher first.
+	Priority int `gorm:"not null;default:0"`
+
+	// Items are the items given by the offer.
+	Items []CatalogOfferItem `gorm:"foreignKey:OfferID"`
+}
+
+// CatalogOfferItem represents an amount of a kind of furniture given by an offer.
+type CatalogOfferItem struct {
+	database.BaseModel
+
+	// OfferID is the ID of the offer giving the items.
+	OfferID uint `gorm:"not null;index"`
+
+	// DefinitionID is the ID of the kind of furniture.
+	DefinitionID uint `gorm:"not null;index"`
+
+	// Definition is the kind of furniture.
+	Definition ItemDefinition `gorm:"foreignKey:DefinitionID"`
+
+	// Amount is the number of items given.
+	Amount int `gorm:"not null;default:1"`
+
+	// ExtraData is the initial state of the items.
+	ExtraData string `gorm:"type:text"`
+}
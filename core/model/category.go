@@ -0,0 +1,17 @@
+package model
+
+import "pixels-emulator/core/database"
+
+// RoomCategory represents a navigator category rooms can be assigned to.
+type RoomCategory struct {
+	database.BaseModel
+
+	// Caption is the display name of the category.
+	Caption string `gorm:"type:varchar(100);not null"`
+
+	// Visible determines if the category is listed to the players.
+	Visible bool `gorm:"not null;default:true"`
+
+	// Permission is the role permission required to assign rooms to the category, empty for everyone.
+	Permission string `gorm:"type:varchar(255)"`
+}
@@ -0,0 +1,25 @@
+package model
+
+import "pixels-emulator/core/database"
+
+// ChatLog represents a chat message said inside a room, kept for moderation.
+// Logs are not constrained to rooms or users, so history survives their removal.
+type ChatLog struct {
+	// BaseModel includes common fields for all models.
+	database.BaseModel
+
+	// RoomID is the ID of the room where the message was said.
+	RoomID uint `gorm:"not null;index"`
+
+	// UserID is the ID of the user who said the message.
+	UserID uint `gorm:"not null;index"`
+
+	// TargetID is the ID of the user receiving the message on whispers, zero otherwise.
+	TargetID uint `gorm:"not null;default:0"`
+
+	// Type is the delivery of the message. (0: Talk, 1: Shout, 2: Whisper)
+	Type int `gorm:"not null;default:0"`
+
+	// Message is the text said.
+	Message string `gorm:"type:varchar(255);not null"`
+}
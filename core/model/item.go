@@ -0,0 +1,119 @@
+package model
+
+import "pixels-emulator/core/database"
+
+const (
+	FloorItem = "s" // FloorItem defines the items placed on the room tiles.
+	WallItem  = "i" // WallItem defines the items placed on the room walls.
+)
+
+// ItemDefinition represents a kind of furniture, shared by every item of its kind.
+type ItemDefinition struct {
+	database.BaseModel
+
+	// SpriteID is the identifier of the furniture in the client furnidata.
+	SpriteID int `gorm:"not null;index"`
+
+	// Name is the class name of the furniture in the client furnidata.
+	Name string `gorm:"type:varchar(100);not null"`
+
+	// Type defines if the furniture is placed on the floor or on the walls.
+	Type string `gorm:"type:varchar(1);not null;default:s"`
+
+	// Width is the amount of tiles occupied along the X axis when not rotated.
+	Width int `gorm:"not null;default:1"`
+
+	// Length is the amount of tiles occupied along the Y axis when not rotated.
+	Length int `gorm:"not null;default:1"`
+
+	// StackHeight is the height added to the tiles by the furniture.
+	StackHeight float64 `gorm:"not null;default:0"`
+
+	// AllowStack determines if other furniture can be placed on top.
+	AllowStack bool `gorm:"not null;default:false"`
+
+	// AllowSit determines if units can sit on the furniture.
+	AllowSit bool `gorm:"not null;default:false"`
+
+	// AllowLay determines if units can lay on the furniture.
+	AllowLay bool `gorm:"not null;default:false"`
+
+	// AllowWalk determines if units can walk over the furniture.
+	AllowWalk bool `gorm:"not null;default:false"`
+
+	// Interaction is the behaviour of the furniture when used.
+	Interaction string `gorm:"type:varchar(100);not null;default:default"`
+
+	// InteractionModes is the amount of states the furniture cycles when used.
+	InteractionModes int `gorm:"not null;default:0"`
+}
+
+// RoomItem represents a furniture owned by a user, placed in a room.
+type RoomItem struct {
+	database.BaseModel
+
+	// RoomID is the ID of the room where the item is placed.
+	RoomID uint `gorm:"not null;index"`
+
+	// UserID is the ID of the owner of the item.
+	UserID uint `gorm:"not null;index;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+
+	// User is the owner of the item.
+	User User `gorm:"foreignKey:UserID"`
+
+	// DefinitionID is the ID of the kind of furniture.
+	DefinitionID uint `gorm:"not null;index"`
+
+	// Definition is the kind of furniture.
+	Definition ItemDefinition `gorm:"foreignKey:DefinitionID"`
+
+	// X is the coordinate of the floor item along the X axis.
+	X int `gorm:"not null;default:0"`
+
+	// Y is the coordinate of the floor item along the Y axis.
+	Y int `gorm:"not null;default:0"`
+
+	// Z is the height where the floor item is placed.
+	Z float64 `gorm:"not null;default:0"`
+
+	// Rotation is the direction the floor item faces.
+	Rotation int `gorm:"not null;default:0"`
+
+	// WallPosition is the location of the wall item in the Nitro format.
+	WallPosition string `gorm:"type:varchar(50)"`
+
+	// ExtraData is the state of the item.
+	ExtraData string `gorm:"type:text"`
+
+	// LimitedNumber is the serial number of a limited edition item, zero otherwise.
+	LimitedNumber int `gorm:"not null;default:0"`
+
+	// LimitedStack is the amount of items of the limited edition, zero otherwise.
+	LimitedStack int `gorm:"not null;default:0"`
+}
+
+// InventoryItem represents a furniture owned by a user, kept in the inventory until placed.
+type InventoryItem struct {
+	database.BaseModel
+
+	// UserID is the ID of the owner of the item.
+	UserID uint `gorm:"not null;index;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+
+	// User is the owner of the item.
+	User User `gorm:"foreignKey:UserID"`
+
+	// DefinitionID is the ID of the kind of furniture.
+	DefinitionID uint `gorm:"not null;index"`
+
+	// Definition is the kind of furniture.
+	Definition ItemDefinition `gorm:"foreignKey:DefinitionID"`
+
+	// ExtraData is the state of the item.
+	ExtraData string `gorm:"type:text"`
+
+	// LimitedNumber is the serial number of a limited edition item, zero otherwise.
+	LimitedNumber int `gorm:"not null;default:0"`
+
+	// LimitedStack is the amount of items of the limited edition, zero otherwise.
+	LimitedStack int `gorm:"not null;default:0"`
+}
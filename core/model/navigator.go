@@ -12,8 +12,8 @@ type NavigatorDisplay struct {
 	// Realm defines the category of the navigator.
 	Realm string `gorm:"type:enum('official_view','hotel_view','roomads_view','myworld_view');not null"`
 
-	// DisplayType deter`gorm:"type:enum('list','thumbnails');not null"`mines how the element is displayed.
-	DisplayType string
+	// DisplayType determines how the element is displayed.
+	DisplayType string `gorm:"type:enum('list','thumbnails');not null"`
 
 	// OrderType defines the sorting method.
 	OrderType string `gorm:"type:enum('activity','order');not null"`
@@ -27,3 +27,61 @@ type NavigatorDisplay struct {
 	// Filter defines how rooms are selected for this display.
 	Filter string `gorm:"type:varchar(50);not null"`
 }
+
+// NavigatorSavedSearch represents a search stored by a user in the navigator.
+type NavigatorSavedSearch struct {
+	database.BaseModel
+
+	// UserID is the ID of the user who saved the search.
+	UserID uint `gorm:"not null;index;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+
+	// Code is the realm or category where the search was made.
+	Code string `gorm:"type:varchar(50);not null"`
+
+	// Filter is the query of the search.
+	Filter string `gorm:"type:varchar(255)"`
+}
+
+// NavigatorPreference represents the navigator window preferences of a user.
+type NavigatorPreference struct {
+	database.BaseModel
+
+	// UserID is the ID of the user. Only one preference is kept by user.
+	UserID uint `gorm:"not null;uniqueIndex;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+
+	// WindowX is the horizontal position of the window. The window columns have no
+	// database default, as gorm would replace the zero positions by it on insert.
+	WindowX int `gorm:"not null"`
+
+	// WindowY is the vertical position of the window.
+	WindowY int `gorm:"not null"`
+
+	// WindowWidth is the width of the window.
+	WindowWidth int `gorm:"not null"`
+
+	// WindowHeight is the height of the window.
+	WindowHeight int `gorm:"not null"`
+
+	// LeftPaneHidden determines if the saved searches pane is hidden.
+	LeftPaneHidden bool `gorm:"not null;default:false"`
+
+	// ResultsMode defines how the search results are displayed by default.
+	ResultsMode int `gorm:"not null;default:0"`
+}
+
+// NavigatorCategoryPreference represents how a user displays a navigator category.
+type NavigatorCategoryPreference struct {
+	database.BaseModel
+
+	// UserID is the ID of the user. Along Code, prevents duplicate entries.
+	UserID uint `gorm:"not null;index;uniqueIndex:user_category_unique;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+
+	// Code is the filter of the category.
+	Code string `gorm:"type:varchar(50);not null;uniqueIndex:user_category_unique"`
+
+	// Collapsed determines if the category rooms are hidden.
+	Collapsed bool `gorm:"not null;default:false"`
+
+	// Thumbnails determines if the category rooms are displayed as thumbnails, nil keeps the display type.
+	Thumbnails *bool
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"pixels-emulator/core/database"
+	"time"
+)
+
+// RoomPromotion represents an event advertising a room until its expiration.
+type RoomPromotion struct {
+	// BaseModel includes common fields for all models.
+	database.BaseModel
+
+	// RoomID is the ID of the promoted room. Rooms only have a promotion at once.
+	RoomID uint `gorm:"not null;uniqueIndex;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+
+	// Title is the name of the promotion.
+	Title string `gorm:"type:varchar(64);not null"`
+
+	// Description is the text of the promotion.
+	Description string `gorm:"type:varchar(255)"`
+
+	// Category is the promotion category chosen on purchase.
+	Category int `gorm:"not null;default:0"`
+
+	// Expires is the moment when the promotion ends.
+	Expires time.Time `gorm:"not null;index"`
+}
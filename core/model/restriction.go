@@ -0,0 +1,39 @@
+package model
+
+import (
+	"pixels-emulator/core/database"
+	"time"
+)
+
+// RoomBan represents a user prohibited to enter a room until its expiration.
+type RoomBan struct {
+	// BaseModel includes common fields for all models.
+	database.BaseModel
+
+	// RoomID is the ID of the room.
+	RoomID uint `gorm:"not null;index;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+
+	// UserID is the ID of the banned user.
+	UserID uint `gorm:"not null;index;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+
+	// User is the banned user.
+	User User `gorm:"foreignKey:UserID"`
+
+	// Expires is the moment when the ban is lifted.
+	Expires time.Time `gorm:"not null;index"`
+}
+
+// RoomMute represents a user prohibited to chat in a room until its expiration.
+type RoomMute struct {
+	// BaseModel includes common fields for all models.
+	database.BaseModel
+
+	// RoomID is the ID of the room.
+	RoomID uint `gorm:"not null;index;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+
+	// UserID is the ID of the muted user.
+	UserID uint `gorm:"not null;index;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+
+	// Expires is the moment when the mute is lifted.
+	Expires time.Time `gorm:"not null;index"`
+}
@@ -25,6 +25,12 @@ type Room struct {
 	// Tags are keywords or labels associated with the room.
 	Tags string `gorm:"type:text"`
 
+	// CategoryID is the navigator category of the room, zero if none.
+	CategoryID uint `gorm:"not null;default:0;index"`
+
+	// Score is the amount of votes received by the room.
+	Score int `gorm:"not null;default:0;index"`
+
 	// IsPublic indicates whether the room is publicly accessible.
 	IsPublic bool `gorm:"not null;default:true"`
 
@@ -43,7 +49,10 @@ type Room struct {
 	// Layout is the height map corresponding to the room.
 	Layout HeightMap `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 
-	// TODO: Correlation Room model, owner, guild, category, votes, staff picks, mute permissions, ban permissions, poll, promotions.
+	// Promotion is the event advertising the room, nil if never promoted.
+	Promotion *RoomPromotion `gorm:"foreignKey:RoomID"`
+
+	// TODO: Correlation Room model, owner, guild, staff picks, mute permissions, ban permissions, poll.
 }
 
 // RoomPermission represents a user's permission to a specific room.
@@ -51,12 +60,24 @@ type RoomPermission struct {
 	// BaseModel includes common fields for all models.
 	database.BaseModel
 
-	// RoomID is the ID of the room.
-	RoomID uint `gorm:"not null;index;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+	// RoomID is the ID of the room. Along UserID, prevents duplicate entries.
+	RoomID uint `gorm:"not null;index;uniqueIndex:room_permission_unique;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
 
 	// UserID is the ID of the user receiving the permission.
-	UserID uint `gorm:"not null;index;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+	UserID uint `gorm:"not null;index;uniqueIndex:room_permission_unique;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+
+	// User is the user receiving the permission.
+	User User `gorm:"foreignKey:UserID"`
+}
+
+// RoomVote represents the vote of a user to a room. Users can only vote once each room.
+type RoomVote struct {
+	// BaseModel includes common fields for all models.
+	database.BaseModel
+
+	// RoomID is the ID of the voted room. Along UserID, prevents duplicate entries.
+	RoomID uint `gorm:"not null;index;uniqueIndex:room_voter_unique;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
 
-	// Unique constraint to prevent duplicate entries.
-	UniqueIndex string `gorm:"uniqueIndex:room_user_unique"`
+	// UserID is the ID of the voter.
+	UserID uint `gorm:"not null;index;uniqueIndex:room_voter_unique;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
 }
@@ -60,7 +60,7 @@ type RoomConfiguration struct {
 	// RollerSpeed indicates the speed of moving objects (rollers) in the room.
 	RollerSpeed float64 `gorm:"not null;default:1.0"`
 
-	// TradeMode represents the trading mode in the room (e.g., "open", "friends_only", "closed").
+	// TradeMode represents the trading mode in the room (e.g., "open", "rights_only", "closed").
 	TradeMode string `gorm:"type:varchar(50);not null"`
 
 	// Room is the associated room for this configuration.
@@ -0,0 +1,15 @@
+package model
+
+import "pixels-emulator/core/database"
+
+// RoomVisit represents the last time a user entered a room, kept in UpdatedAt.
+type RoomVisit struct {
+	// BaseModel includes common fields for all models.
+	database.BaseModel
+
+	// RoomID is the ID of the visited room. Along UserID, prevents duplicate entries.
+	RoomID uint `gorm:"not null;index;uniqueIndex:room_visitor_unique;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+
+	// UserID is the ID of the visitor.
+	UserID uint `gorm:"not null;index;uniqueIndex:room_visitor_unique;constraint:OnDelete:CASCADE,OnUpdate:CASCADE;"`
+}
@@ -66,6 +66,11 @@ func (p *RawPacket) AddBoolean(value bool) {
 	p.content = append(p.content, b)
 }
 
+// AddByte adds a single byte to the packet content.
+func (p *RawPacket) AddByte(value byte) {
+	p.content = append(p.content, value)
+}
+
 // AddString adds a UTF-8 string to the packet content, preceded by its length in bytes.
 func (p *RawPacket) AddString(value string) {
 	length := int16(len(value))
@@ -121,6 +126,16 @@ func (p *RawPacket) ReadBoolean() (bool, error) {
 	return value == 1, nil
 }
 
+// ReadByte reads a single byte from the packet content.
+func (p *RawPacket) ReadByte() (byte, error) {
+	if p.offset+1 > len(p.content) {
+		return 0, errors.New("not enough bytes to read byte")
+	}
+	value := p.content[p.offset]
+	p.offset++
+	return value, nil
+}
+
 // ReadString reads a string from the packet content. It expects the string to be preceded by a short indicating its length.
 func (p *RawPacket) ReadString() (string, error) {
 	length, err := p.ReadShort()
@@ -42,6 +42,25 @@ func TestAddBoolean(t *testing.T) {
 	}
 }
 
+func TestAddByte(t *testing.T) {
+	packet := protocol.NewPacket(0x1234)
+	packet.AddByte(7)
+
+	raw, err := protocol.FromBytes(packet.ToBytes())
+	if err != nil {
+		t.Fatalf("FromBytes failed: %v", err)
+	}
+
+	b, err := raw.ReadByte()
+	if err != nil || b != 7 {
+		t.Errorf("ReadByte failed. Expected 7, got %v (%v)", b, err)
+	}
+
+	if _, err = raw.ReadByte(); err == nil {
+		t.Errorf("ReadByte must fail when content is consumed")
+	}
+}
+
 func TestAddString(t *testing.T) {
 	packet := protocol.NewPacket(0x1234)
 	packet.AddString("hello")
@@ -1,10 +1,13 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 	"os"
 	"pixels-emulator/core/config"
+	"pixels-emulator/core/cycle"
 	"pixels-emulator/core/database"
 	"pixels-emulator/core/event"
 	"pixels-emulator/core/log"
@@ -30,6 +33,7 @@ type MainServer struct {
 	database         *gorm.DB                   // database provides a connection for ORM.
 	roomStore        room.Store                 // roomStore provides an in-memory storage to control the rooms.
 	userStore        user.Store                 // userStore provides an in-memory storage to control the users.
+	ticker           cycle.Ticker               // ticker provides the cycle engine of the rooms.
 }
 
 var (
@@ -80,10 +84,28 @@ func (s *MainServer) Reload(log bool) {
 	}
 }
 
-// Stop stops the server and closes active connections.
+// Stop stops the server, closes active connections and persists the loaded rooms.
 func (s *MainServer) Stop() error {
 	s.connStore.CloseActive()
-	return nil
+	s.ticker.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	rooms, err := s.roomStore.Records().GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, r := range rooms {
+		if fErr := r.Flush(ctx, s.database); fErr != nil {
+			s.logger.Error("Error flushing room", zap.Uint("room", r.Id), zap.Error(fErr))
+			errs = append(errs, fErr)
+		}
+	}
+
+	return errors.Join(errs...)
 }
 
 // Config returns the configuration of the server.
@@ -136,6 +158,11 @@ func (s *MainServer) UserStore() user.Store {
 	return s.userStore
 }
 
+// Ticker returns the room cycle engine.
+func (s *MainServer) Ticker() cycle.Ticker {
+	return s.ticker
+}
+
 func setupServer() *MainServer {
 
 	var setupErr error
@@ -184,6 +211,9 @@ func setupServer() *MainServer {
 	sc := scheduler.NewCronScheduler()
 	sc.Start()
 	logger.Info("Started scheduler")
+	tk := cycle.NewPoolTicker(int(cfg.Room.CycleWorkers), logger)
+	tk.Start()
+	logger.Info("Started room cycles", zap.Uint16("workers", cfg.Room.CycleWorkers))
 
 	return &MainServer{
 		config:           cfg,
@@ -196,5 +226,6 @@ func setupServer() *MainServer {
 		database:         db,
 		userStore:        user.NewUserStore(),
 		roomStore:        room.NewRoomStore(),
+		ticker:           tk,
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 	"pixels-emulator/core/config"
+	"pixels-emulator/core/cycle"
 	"pixels-emulator/core/event"
 	"pixels-emulator/core/protocol"
 	"pixels-emulator/core/registry"
@@ -88,3 +89,9 @@ func (m *Server) UserStore() user.Store {
 	args := m.Called()
 	return args.Get(0).(user.Store)
 }
+
+// Ticker simulates the Ticker method of the Server instance.
+func (m *Server) Ticker() cycle.Ticker {
+	args := m.Called()
+	return args.Get(0).(cycle.Ticker)
+}
@@ -4,6 +4,7 @@ import (
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 	"pixels-emulator/core/config"
+	"pixels-emulator/core/cycle"
 	"pixels-emulator/core/event"
 	"pixels-emulator/core/protocol"
 	"pixels-emulator/core/registry"
@@ -49,4 +50,7 @@ type Server interface {
 
 	// UserStore provides the in-memory loaded users.
 	UserStore() user.Store
+
+	// Ticker provides the cycle engine of the loaded rooms.
+	Ticker() cycle.Ticker
 }
@@ -9,9 +9,16 @@ import (
 // ModelMigration setups model migrations.
 func ModelMigration(logger *zap.Logger, db *gorm.DB) error {
 	logger.Info("Performing database migrations")
+	if err := legacyMigration(db); err != nil {
+		return err
+	}
+
 	return db.AutoMigrate(
 		&model.HeightMap{},
 		&model.NavigatorDisplay{},
+		&model.NavigatorSavedSearch{},
+		&model.NavigatorPreference{},
+		&model.NavigatorCategoryPreference{},
 		&model.User{},
 		&model.SSOTicket{},
 		&model.Room{},
@@ -19,5 +26,39 @@ func ModelMigration(logger *zap.Logger, db *gorm.DB) error {
 		&model.RoomPermission{},
 		&model.Role{},
 		&model.RolePermission{},
+		&model.ChatLog{},
+		&model.RoomBan{},
+		&model.RoomMute{},
+		&model.RoomVisit{},
+		&model.RoomVote{},
+		&model.RoomCategory{},
+		&model.RoomPromotion{},
+		&model.ItemDefinition{},
+		&model.RoomItem{},
+		&model.InventoryItem{},
+		&model.UserBadge{},
+		&model.UserEffect{},
+		&model.CatalogPage{},
+		&model.CatalogOffer{},
+		&model.CatalogOfferItem{},
 	)
 }
+
+// legacyMigration drops the schema replaced by newer models, as AutoMigrate never removes it.
+func legacyMigration(db *gorm.DB) error {
+	m := db.Migrator()
+
+	// Room permissions were unique by a placeholder column instead of the room and user.
+	if m.HasIndex(&model.RoomPermission{}, "room_user_unique") {
+		if err := m.DropIndex(&model.RoomPermission{}, "room_user_unique"); err != nil {
+			return err
+		}
+	}
+	if m.HasColumn(&model.RoomPermission{}, "unique_index") {
+		if err := m.DropColumn(&model.RoomPermission{}, "unique_index"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package util
+
+import (
+	"sync"
+	"time"
+)
+
+// FloodLimiter controls the amount of actions an issuer can perform
+// inside a time window, muting the issuer for a while when exceeded.
+type FloodLimiter struct {
+	mu      sync.Mutex             // Ensures thread safety
+	window  time.Duration          // Window where actions are counted
+	mute    time.Duration          // Mute duration when limit is exceeded
+	actions map[string][]time.Time // Tracks the action times per issuer
+	muted   map[string]time.Time   // Tracks the mute expiration per issuer
+}
+
+// NewFloodLimiter initializes a FloodLimiter.
+func NewFloodLimiter(window, mute time.Duration) *FloodLimiter {
+	return &FloodLimiter{
+		window:  window,
+		mute:    mute,
+		actions: make(map[string][]time.Time),
+		muted:   make(map[string]time.Time),
+	}
+}
+
+// Allow registers an action of the issuer, allowing up to limit actions inside the window.
+// Returns false and the remaining mute time if the issuer is flooding.
+func (fl *FloodLimiter) Allow(issuer string, limit int, now time.Time) (bool, time.Duration) {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+
+	if until, ok := fl.muted[issuer]; ok {
+		if now.Before(until) {
+			return false, until.Sub(now)
+		}
+		delete(fl.muted, issuer)
+	}
+
+	recent := fl.actions[issuer][:0]
+	for _, t := range fl.actions[issuer] {
+		if now.Sub(t) < fl.window {
+			recent = append(recent, t)
+		}
+	}
+
+	if len(recent) >= limit {
+		delete(fl.actions, issuer)
+		fl.muted[issuer] = now.Add(fl.mute)
+		return false, fl.mute
+	}
+
+	fl.actions[issuer] = append(recent, now)
+	return true, 0
+}
+
+// Forget removes every record of the issuer.
+func (fl *FloodLimiter) Forget(issuer string) {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+	delete(fl.actions, issuer)
+	delete(fl.muted, issuer)
+}
@@ -0,0 +1,69 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFloodLimiter_Allow verifies issuers are muted after exceeding the limit.
+func TestFloodLimiter_Allow(t *testing.T) {
+	fl := NewFloodLimiter(4*time.Second, 30*time.Second)
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		ok, _ := fl.Allow("user", 3, now.Add(time.Duration(i)*time.Second))
+		assert.True(t, ok)
+	}
+
+	ok, mute := fl.Allow("user", 3, now.Add(3*time.Second))
+	assert.False(t, ok)
+	assert.Equal(t, 30*time.Second, mute)
+
+	ok, mute = fl.Allow("user", 3, now.Add(13*time.Second))
+	assert.False(t, ok)
+	assert.Equal(t, 20*time.Second, mute)
+
+	ok, _ = fl.Allow("user", 3, now.Add(34*time.Second))
+	assert.True(t, ok)
+}
+
+// TestFloodLimiter_Window verifies old actions are not counted.
+func TestFloodLimiter_Window(t *testing.T) {
+	fl := NewFloodLimiter(4*time.Second, 30*time.Second)
+	now := time.Now()
+
+	for i := 0; i < 10; i++ {
+		ok, _ := fl.Allow("user", 2, now.Add(time.Duration(i)*3*time.Second))
+		assert.True(t, ok)
+	}
+}
+
+// TestFloodLimiter_Issuers verifies issuers are limited independently.
+func TestFloodLimiter_Issuers(t *testing.T) {
+	fl := NewFloodLimiter(4*time.Second, 30*time.Second)
+	now := time.Now()
+
+	ok, _ := fl.Allow("a", 1, now)
+	assert.True(t, ok)
+	ok, _ = fl.Allow("a", 1, now)
+	assert.False(t, ok)
+
+	ok, _ = fl.Allow("b", 1, now)
+	assert.True(t, ok)
+}
+
+// TestFloodLimiter_Forget verifies records are removed.
+func TestFloodLimiter_Forget(t *testing.T) {
+	fl := NewFloodLimiter(4*time.Second, 30*time.Second)
+	now := time.Now()
+
+	fl.Allow("a", 1, now)
+	ok, _ := fl.Allow("a", 1, now)
+	assert.False(t, ok)
+
+	fl.Forget("a")
+	ok, _ = fl.Allow("a", 1, now)
+	assert.True(t, ok)
+}
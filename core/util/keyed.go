@@ -0,0 +1,46 @@
+package util
+
+import (
+	"sync"
+)
+
+// KeyedMutex provides a mutual exclusion lock for each key, released once unused.
+type KeyedMutex struct {
+	mu    sync.Mutex           // Guards the locks in use
+	locks map[string]*keyedRef // Tracks the lock and waiters per key
+}
+
+// keyedRef counts the holders and waiters of a key lock.
+type keyedRef struct {
+	sync.Mutex
+	refs int
+}
+
+// NewKeyedMutex initializes a KeyedMutex.
+func NewKeyedMutex() *KeyedMutex {
+	return &KeyedMutex{
+		locks: make(map[string]*keyedRef),
+	}
+}
+
+// Lock acquires the lock of a key, providing the function releasing it.
+func (km *KeyedMutex) Lock(key string) func() {
+	km.mu.Lock()
+	ref, ok := km.locks[key]
+	if !ok {
+		ref = &keyedRef{}
+		km.locks[key] = ref
+	}
+	ref.refs++
+	km.mu.Unlock()
+
+	ref.Lock()
+	return func() {
+		ref.Unlock()
+		km.mu.Lock()
+		defer km.mu.Unlock()
+		if ref.refs--; ref.refs == 0 {
+			delete(km.locks, key)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package util
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestKeyedMutex_Lock verifies the holders of a key are serialized and the unused locks released.
+func TestKeyedMutex_Lock(t *testing.T) {
+	km := NewKeyedMutex()
+
+	var wg sync.WaitGroup
+	held, counter := 0, 0
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock := km.Lock("room")
+			defer unlock()
+			held++
+			assert.Equal(t, 1, held)
+			counter++
+			held--
+		}()
+	}
+
+	other := km.Lock("other")
+	wg.Wait()
+	other()
+
+	assert.Equal(t, 50, counter)
+	assert.Empty(t, km.locks)
+}
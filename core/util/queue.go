@@ -10,7 +10,8 @@ type Item[T any] struct {
 	Value T
 }
 
-// Queue represents a thread-safe FILO queue.
+// Queue represents a thread-safe queue, which can be consumed
+// from the back (FILO) with Dequeue or from the front (FIFO) with Shift.
 type Queue[T any] struct {
 	mu    sync.Mutex
 	items []Item[T]
@@ -56,6 +57,45 @@ func (q *Queue[T]) Dequeue() (T, bool) {
 	return item.Value, true
 }
 
+// Shift removes and returns the first item in the queue (FIFO behavior).
+func (q *Queue[T]) Shift() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var zero T
+	if len(q.items) == 0 {
+		return zero, false
+	}
+
+	item := q.items[0]
+	q.removeAt(0)
+
+	return item.Value, true
+}
+
+// Position returns the 1-based position of an item from the front of the queue, 0 if absent.
+func (q *Queue[T]) Position(id string) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if pos, exists := q.index[id]; exists {
+		return pos + 1
+	}
+	return 0
+}
+
+// Values returns the items of the queue from the front.
+func (q *Queue[T]) Values() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	values := make([]T, len(q.items))
+	for i, item := range q.items {
+		values[i] = item.Value
+	}
+	return values
+}
+
 // Remove deletes an item by ID from the queue if it exists.
 func (q *Queue[T]) Remove(id string) {
 	q.mu.Lock()
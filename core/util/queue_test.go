@@ -47,3 +47,39 @@ func TestQueue(t *testing.T) {
 		t.Errorf("expected size 1 after removal, got %d", q.Size())
 	}
 }
+
+func TestQueue_Shift(t *testing.T) {
+	q := NewQueue[string]()
+
+	q.Enqueue("1", "first")
+	q.Enqueue("2", "second")
+	q.Enqueue("3", "third")
+
+	if pos := q.Position("2"); pos != 2 {
+		t.Errorf("expected position 2, got %d", pos)
+	}
+
+	item, ok := q.Shift()
+	if !ok || item != "first" {
+		t.Errorf("expected 'first', got %v", item)
+	}
+
+	if pos := q.Position("2"); pos != 1 {
+		t.Errorf("expected position 1 after shift, got %d", pos)
+	}
+
+	if pos := q.Position("1"); pos != 0 {
+		t.Errorf("expected shifted item to be absent, got %d", pos)
+	}
+
+	values := q.Values()
+	if len(values) != 2 || values[0] != "second" || values[1] != "third" {
+		t.Errorf("expected remaining values in order, got %v", values)
+	}
+
+	q.Shift()
+	q.Shift()
+	if _, ok := q.Shift(); ok {
+		t.Errorf("expected empty queue")
+	}
+}
@@ -0,0 +1,88 @@
+package inventory
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"pixels-emulator/core/model"
+	"pixels-emulator/inventory/encode"
+	"pixels-emulator/inventory/message"
+	"sort"
+)
+
+// Badges provides the badges earned by a user, sorted by identifier.
+func Badges(ctx context.Context, db *gorm.DB, user uint) ([]model.UserBadge, error) {
+	var badges []model.UserBadge
+	err := db.WithContext(ctx).Where("user_id = ?", user).Order("id").Find(&badges).Error
+	return badges, err
+}
+
+// BadgeFragments provides the packets of the earned badges, split as Nitro expects.
+// Users without badges are sent a single empty fragment.
+func BadgeFragments(badges []model.UserBadge) []*message.BadgesPacket {
+
+	total := max(1, (len(badges)+FragmentSize-1)/FragmentSize)
+
+	res := make([]*message.BadgesPacket, total)
+	for f := range res {
+		chunk := badges[min(f*FragmentSize, len(badges)):min((f+1)*FragmentSize, len(badges))]
+		enc := make([]encode.Badge, len(chunk))
+		for n, b := range chunk {
+			enc[n] = encode.Badge{BadgeId: int32(b.ID), Code: b.Code}
+		}
+		res[f] = &message.BadgesPacket{Total: int32(total), Fragment: int32(f), Badges: enc}
+	}
+	return res
+
+}
+
+// Worn provides the protocol version of the badges worn, sorted by slot.
+func Worn(badges []model.UserBadge) []encode.WornBadge {
+	var res []encode.WornBadge
+	for _, b := range badges {
+		if b.Slot > 0 {
+			res = append(res, encode.WornBadge{Slot: int32(b.Slot), Code: b.Code})
+		}
+	}
+	sort.Slice(res, func(a, b int) bool { return res[a].Slot < res[b].Slot })
+	return res
+}
+
+// Slots provides the slot where each earned badge is requested to be worn. Badges not
+// earned, slots out of bounds and repeated badges or slots are ignored.
+func Slots(badges []model.UserBadge, requested []encode.WornBadge) map[string]int {
+
+	earned := make(map[string]bool, len(badges))
+	for _, b := range badges {
+		earned[b.Code] = true
+	}
+
+	res := make(map[string]int)
+	taken := make(map[int32]bool)
+	for _, w := range requested {
+		if w.Slot < 1 || w.Slot > message.WornSlots || taken[w.Slot] || !earned[w.Code] {
+			continue
+		}
+		if _, ok := res[w.Code]; ok {
+			continue
+		}
+		taken[w.Slot] = true
+		res[w.Code] = int(w.Slot)
+	}
+	return res
+
+}
+
+// Wear replaces the badges worn by a user with the ones on each slot.
+func Wear(ctx context.Context, db *gorm.DB, user uint, slots map[string]int) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.UserBadge{}).Where("user_id = ? AND slot > 0", user).Update("slot", 0).Error; err != nil {
+			return err
+		}
+		for code, slot := range slots {
+			if err := tx.Model(&model.UserBadge{}).Where("user_id = ? AND code = ?", user, code).Update("slot", slot).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,54 @@
+package inventory
+
+import (
+	"pixels-emulator/core/database"
+	"pixels-emulator/core/model"
+	"pixels-emulator/inventory/encode"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// badge creates an earned badge worn on a slot.
+func badge(id uint, code string, slot int) model.UserBadge {
+	return model.UserBadge{BaseModel: database.BaseModel{ID: id}, UserID: 1, Code: code, Slot: slot}
+}
+
+// TestBadgeFragments verifies the badges are split in fragments, sending at least one.
+func TestBadgeFragments(t *testing.T) {
+	f := BadgeFragments(nil)
+	assert.Len(t, f, 1)
+	assert.Equal(t, int32(1), f[0].Total)
+	assert.Empty(t, f[0].Badges)
+
+	badges := make([]model.UserBadge, FragmentSize+1)
+	for n := range badges {
+		badges[n] = badge(uint(n+1), "B", 0)
+	}
+	f = BadgeFragments(badges)
+	assert.Len(t, f, 2)
+	assert.Len(t, f[0].Badges, FragmentSize)
+	assert.Equal(t, []encode.Badge{{BadgeId: int32(FragmentSize + 1), Code: "B"}}, f[1].Badges)
+	assert.Equal(t, int32(1), f[1].Fragment)
+}
+
+// TestWorn verifies only the worn badges are provided, by slot.
+func TestWorn(t *testing.T) {
+	worn := Worn([]model.UserBadge{badge(1, "ADM", 3), badge(2, "HC1", 0), badge(3, "VIP", 1)})
+	assert.Equal(t, []encode.WornBadge{{Slot: 1, Code: "VIP"}, {Slot: 3, Code: "ADM"}}, worn)
+}
+
+// TestSlots verifies only earned badges are worn on valid and free slots.
+func TestSlots(t *testing.T) {
+	badges := []model.UserBadge{badge(1, "ADM", 0), badge(2, "HC1", 0), badge(3, "VIP", 0)}
+	slots := Slots(badges, []encode.WornBadge{
+		{Slot: 1, Code: "ADM"},
+		{Slot: 2, Code: "NOPE"},
+		{Slot: 3, Code: ""},
+		{Slot: 1, Code: "HC1"},
+		{Slot: 4, Code: "ADM"},
+		{Slot: 6, Code: "VIP"},
+		{Slot: 5, Code: "VIP"},
+	})
+	assert.Equal(t, map[string]int{"ADM": 1, "VIP": 5}, slots)
+}
@@ -0,0 +1,50 @@
+package inventory
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"pixels-emulator/core/model"
+	"pixels-emulator/inventory/encode"
+	"time"
+)
+
+// Effects provides the avatar effects kept by a user, sorted by identifier.
+func Effects(ctx context.Context, db *gorm.DB, user uint) ([]model.UserEffect, error) {
+	var effects []model.UserEffect
+	err := db.WithContext(ctx).Where("user_id = ?", user).Order("id").Find(&effects).Error
+	return effects, err
+}
+
+// EncodeEffect provides the protocol version of an avatar effect at a moment. An expired
+// activation is not counted anymore, so effects without activations left are not encoded.
+func EncodeEffect(e *model.UserEffect, now time.Time) (encode.Effect, bool) {
+
+	enc := encode.Effect{
+		Type:        int32(e.Effect),
+		Duration:    int32(e.Duration),
+		Inactive:    int32(e.Quantity),
+		SecondsLeft: encode.NotActive,
+		Permanent:   e.Duration == 0,
+	}
+
+	if e.ActivatedAt != nil && !enc.Permanent {
+		enc.Inactive--
+		if left := e.ActivatedAt.Add(time.Duration(e.Duration) * time.Second).Sub(now); left > 0 {
+			enc.SecondsLeft = int32(left.Seconds())
+		}
+	}
+
+	return enc, enc.Inactive > 0 || enc.SecondsLeft != encode.NotActive
+
+}
+
+// EncodeEffects provides the protocol version of the avatar effects still available at a moment.
+func EncodeEffects(effects []model.UserEffect, now time.Time) []encode.Effect {
+	res := make([]encode.Effect, 0, len(effects))
+	for i := range effects {
+		if enc, ok := EncodeEffect(&effects[i], now); ok {
+			res = append(res, enc)
+		}
+	}
+	return res
+}
@@ -0,0 +1,36 @@
+package inventory
+
+import (
+	"pixels-emulator/core/model"
+	"pixels-emulator/inventory/encode"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestEncodeEffect verifies the running activations and the activations left of the effects.
+func TestEncodeEffect(t *testing.T) {
+	now := time.Now()
+	started := now.Add(-time.Minute)
+	expired := now.Add(-time.Hour)
+
+	enc, ok := EncodeEffect(&model.UserEffect{Effect: 4, Duration: 600, Quantity: 2}, now)
+	assert.True(t, ok)
+	assert.Equal(t, encode.Effect{Type: 4, Duration: 600, Inactive: 2, SecondsLeft: encode.NotActive}, enc)
+
+	enc, ok = EncodeEffect(&model.UserEffect{Effect: 4, Duration: 600, Quantity: 2, ActivatedAt: &started}, now)
+	assert.True(t, ok)
+	assert.Equal(t, int32(1), enc.Inactive)
+	assert.Equal(t, int32(540), enc.SecondsLeft)
+
+	_, ok = EncodeEffect(&model.UserEffect{Effect: 4, Duration: 600, Quantity: 1, ActivatedAt: &expired}, now)
+	assert.False(t, ok, "effects without activations left are not encoded")
+
+	enc, ok = EncodeEffect(&model.UserEffect{Effect: 108, Quantity: 1, ActivatedAt: &expired}, now)
+	assert.True(t, ok)
+	assert.True(t, enc.Permanent)
+	assert.Equal(t, encode.NotActive, enc.SecondsLeft)
+
+	assert.Len(t, EncodeEffects([]model.UserEffect{{Effect: 4, Duration: 600, Quantity: 1, ActivatedAt: &expired}, {Effect: 5, Quantity: 1}}, now), 1)
+}
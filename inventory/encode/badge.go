@@ -0,0 +1,49 @@
+package encode
+
+import "pixels-emulator/core/protocol"
+
+// Badge represents a badge earned by the user.
+type Badge struct {
+	protocol.Encodable
+	BadgeId int32  // BadgeId is the unique identifier of the badge.
+	Code    string // Code is the identifier of the badge image.
+}
+
+// Encode adds current data to a packet.
+func (e *Badge) Encode(pck *protocol.RawPacket) {
+	pck.AddInt(e.BadgeId)
+	pck.AddString(e.Code)
+}
+
+// Decode reads the badge from a packet.
+func (e *Badge) Decode(pck *protocol.RawPacket) error {
+	var err error
+	if e.BadgeId, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	e.Code, err = pck.ReadString()
+	return err
+}
+
+// WornBadge represents a badge worn on a slot of the user profile.
+type WornBadge struct {
+	protocol.Encodable
+	Slot int32  // Slot is the profile position of the badge, starting at one.
+	Code string // Code is the identifier of the badge image, empty if the slot is free.
+}
+
+// Encode adds current data to a packet.
+func (e *WornBadge) Encode(pck *protocol.RawPacket) {
+	pck.AddInt(e.Slot)
+	pck.AddString(e.Code)
+}
+
+// Decode reads the worn badge from a packet.
+func (e *WornBadge) Decode(pck *protocol.RawPacket) error {
+	var err error
+	if e.Slot, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	e.Code, err = pck.ReadString()
+	return err
+}
@@ -0,0 +1,30 @@
+package encode
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"testing"
+)
+
+// TestBadge_Encode check if encode is done correctly for earned and worn badges.
+func TestBadge_Encode(t *testing.T) {
+
+	badge := &Badge{BadgeId: 3, Code: "ADM"}
+	pck := protocol.NewPacket(100)
+	badge.Encode(&pck)
+	dec, err := protocol.FromBytes(pck.ToBytes())
+	assert.NoError(t, err, "Raw decoding must not have an error")
+	decBadge := &Badge{}
+	assert.NoError(t, decBadge.Decode(dec), "Decoding must not have an error")
+	assert.Equal(t, badge, decBadge)
+
+	worn := &WornBadge{Slot: 2, Code: "ADM"}
+	pck = protocol.NewPacket(100)
+	worn.Encode(&pck)
+	dec, err = protocol.FromBytes(pck.ToBytes())
+	assert.NoError(t, err, "Raw decoding must not have an error")
+	decWorn := &WornBadge{}
+	assert.NoError(t, decWorn.Decode(dec), "Decoding must not have an error")
+	assert.Equal(t, worn, decWorn)
+
+}
@@ -0,0 +1,39 @@
+package encode
+
+import "pixels-emulator/core/protocol"
+
+// NotActive defines the effects without a running activation.
+const NotActive int32 = -1
+
+// Effect represents an avatar effect kept by the user.
+type Effect struct {
+	protocol.Encodable
+	Type        int32 // Type is the identifier of the avatar effect.
+	SubType     int32 // SubType is the variant of the avatar effect.
+	Duration    int32 // Duration are the seconds each activation lasts.
+	Inactive    int32 // Inactive is the amount of activations not started yet.
+	SecondsLeft int32 // SecondsLeft are the seconds until the running activation ends, NotActive if none.
+	Permanent   bool  // Permanent determines if the effect never ends.
+}
+
+// Encode adds current data to a packet.
+func (e *Effect) Encode(pck *protocol.RawPacket) {
+	pck.AddInt(e.Type)
+	pck.AddInt(e.SubType)
+	pck.AddInt(e.Duration)
+	pck.AddInt(e.Inactive)
+	pck.AddInt(e.SecondsLeft)
+	pck.AddBoolean(e.Permanent)
+}
+
+// Decode reads the effect from a packet.
+func (e *Effect) Decode(pck *protocol.RawPacket) error {
+	var err error
+	for _, v := range []*int32{&e.Type, &e.SubType, &e.Duration, &e.Inactive, &e.SecondsLeft} {
+		if *v, err = pck.ReadInt(); err != nil {
+			return err
+		}
+	}
+	e.Permanent, err = pck.ReadBoolean()
+	return err
+}
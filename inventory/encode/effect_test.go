@@ -0,0 +1,29 @@
+package encode
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"testing"
+)
+
+// TestEffect_Encode check if encode is done correctly for running and permanent effects.
+func TestEffect_Encode(t *testing.T) {
+
+	effects := []*Effect{
+		{Type: 4, Duration: 3600, Inactive: 2, SecondsLeft: 120},
+		{Type: 108, Inactive: 1, SecondsLeft: NotActive, Permanent: true},
+	}
+
+	for _, effect := range effects {
+		pck := protocol.NewPacket(100)
+		effect.Encode(&pck)
+
+		dec, err := protocol.FromBytes(pck.ToBytes())
+		assert.NoError(t, err, "Raw decoding must not have an error")
+
+		decEffect := &Effect{}
+		assert.NoError(t, decEffect.Decode(dec), "Decoding must not have an error")
+		assert.Equal(t, effect, decEffect)
+	}
+
+}
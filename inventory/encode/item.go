@@ -0,0 +1,149 @@
+package encode
+
+import (
+	"pixels-emulator/core/protocol"
+	roomEncode "pixels-emulator/room/encode"
+	"strings"
+)
+
+// Category defines how the client displays an item in the inventory.
+type Category int32
+
+const (
+	CategoryDefault   Category = 1 // CategoryDefault defines the items placed in the rooms.
+	CategoryWallpaper Category = 2 // CategoryWallpaper defines the wall decorations applied to the room.
+	CategoryFloor     Category = 3 // CategoryFloor defines the floor decorations applied to the room.
+	CategoryLandscape Category = 4 // CategoryLandscape defines the landscapes applied to the room.
+	CategoryPoster    Category = 6 // CategoryPoster defines the posters hung on the walls.
+)
+
+const (
+	FloorType = "S" // FloorType defines the items placed on the room tiles.
+	WallType  = "I" // WallType defines the items placed on the room walls.
+)
+
+// NoExpiration defines items which are never removed.
+const NoExpiration int32 = -1
+
+// NoRoom defines items not linked to any room.
+const NoRoom int32 = -1
+
+// InventoryItem represents an item kept in the user inventory.
+type InventoryItem struct {
+	protocol.Encodable
+	ItemId      int32    // ItemId is the unique identifier of the item.
+	Type        string   // Type defines if the item is placed on the floor or on the walls.
+	Ref         int32    // Ref is the identifier of the item used when placed.
+	Sprite      int32    // Sprite is the identifier of the furniture in the furnidata.
+	Category    Category // Category defines how the item is displayed.
+	Data        string   // Data is the state of the item.
+	Serial      int32    // Serial is the limited edition number of the item, zero if not limited.
+	Series      int32    // Series is the amount of items of the limited edition.
+	Recyclable  bool     // Recyclable determines if the item can be recycled.
+	Tradable    bool     // Tradable determines if the item can be traded.
+	Groupable   bool     // Groupable determines if the item is grouped with the items of its kind.
+	Sellable    bool     // Sellable determines if the item can be sold in the marketplace.
+	Expiration  int32    // Expiration are the seconds until the item is removed.
+	RentStarted bool     // RentStarted determines if the rent period is running.
+	RoomId      int32    // RoomId is the room linked to the item.
+	SlotId      string   // SlotId is the clothing slot of floor items.
+	Extra       int32    // Extra is an additional value of floor items.
+}
+
+// Encode adds current data to a packet. Limited items send their serial and edition
+// size after their state, and only floor items send their slot and extra value.
+func (e *InventoryItem) Encode(pck *protocol.RawPacket) {
+	pck.AddInt(e.ItemId)
+	pck.AddString(e.Type)
+	pck.AddInt(e.Ref)
+	pck.AddInt(e.Sprite)
+	pck.AddInt(int32(e.Category))
+	if e.Series > 0 {
+		pck.AddInt(roomEncode.LegacyData | roomEncode.LimitedData)
+		pck.AddString(e.Data)
+		pck.AddInt(e.Serial)
+		pck.AddInt(e.Series)
+	} else {
+		pck.AddInt(roomEncode.LegacyData)
+		pck.AddString(e.Data)
+	}
+	pck.AddBoolean(e.Recyclable)
+	pck.AddBoolean(e.Tradable)
+	pck.AddBoolean(e.Groupable)
+	pck.AddBoolean(e.Sellable)
+	pck.AddInt(e.Expiration)
+	pck.AddBoolean(e.RentStarted)
+	pck.AddInt(e.RoomId)
+	if e.Type == FloorType {
+		pck.AddString(e.SlotId)
+		pck.AddInt(e.Extra)
+	}
+}
+
+// Decode reads the item from a packet.
+func (e *InventoryItem) Decode(pck *protocol.RawPacket) error {
+
+	var err error
+	if e.ItemId, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	t, err := pck.ReadString()
+	if err != nil {
+		return err
+	}
+	e.Type = strings.ToUpper(t)
+
+	if e.Ref, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	if e.Sprite, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	category, err := pck.ReadInt()
+	if err != nil {
+		return err
+	}
+	e.Category = Category(category)
+
+	flags, err := pck.ReadInt()
+	if err != nil {
+		return err
+	}
+	if e.Data, err = pck.ReadString(); err != nil {
+		return err
+	}
+	if flags&roomEncode.LimitedData > 0 {
+		if e.Serial, err = pck.ReadInt(); err != nil {
+			return err
+		}
+		if e.Series, err = pck.ReadInt(); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range []*bool{&e.Recyclable, &e.Tradable, &e.Groupable, &e.Sellable} {
+		if *v, err = pck.ReadBoolean(); err != nil {
+			return err
+		}
+	}
+
+	if e.Expiration, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	if e.RentStarted, err = pck.ReadBoolean(); err != nil {
+		return err
+	}
+	if e.RoomId, err = pck.ReadInt(); err != nil {
+		return err
+	}
+
+	if e.Type != FloorType {
+		return nil
+	}
+	if e.SlotId, err = pck.ReadString(); err != nil {
+		return err
+	}
+	e.Extra, err = pck.ReadInt()
+	return err
+
+}
@@ -0,0 +1,30 @@
+package encode
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"testing"
+)
+
+// TestInventoryItem_Encode check if encode is done correctly for both item types.
+func TestInventoryItem_Encode(t *testing.T) {
+
+	items := []*InventoryItem{
+		{ItemId: 5, Type: FloorType, Ref: 5, Sprite: 13, Category: CategoryDefault, Data: "1", Tradable: true, Groupable: true, Expiration: NoExpiration, RoomId: NoRoom, SlotId: "", Extra: 0},
+		{ItemId: 6, Type: FloorType, Ref: 6, Sprite: 13, Category: CategoryDefault, Data: "0", Serial: 7, Series: 100, Tradable: true, Expiration: NoExpiration, RoomId: NoRoom},
+		{ItemId: 7, Type: WallType, Ref: 7, Sprite: 4001, Category: CategoryPoster, Data: "12", Expiration: NoExpiration, RoomId: NoRoom},
+	}
+
+	for _, item := range items {
+		pck := protocol.NewPacket(100)
+		item.Encode(&pck)
+
+		dec, err := protocol.FromBytes(pck.ToBytes())
+		assert.NoError(t, err, "Raw decoding must not have an error")
+
+		decItem := &InventoryItem{}
+		assert.NoError(t, decItem.Decode(dec), "Decoding must not have an error")
+		assert.Equal(t, item, decItem)
+	}
+
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/inventory"
+	"pixels-emulator/inventory/message"
+	"pixels-emulator/room"
+	"pixels-emulator/user"
+	"strconv"
+)
+
+// InventoryBadgesHandler sends the badges earned by the players and
+// updates the badges worn on their profile.
+type InventoryBadgesHandler struct {
+	logger    *zap.Logger // logger for packet processing details.
+	db        *gorm.DB    // db is the database to load and wear the badges.
+	roomStore room.Store  // roomStore is the room store to show the worn badges in the player room.
+	userStore user.Store  // userStore is the user store to check user related conn.
+}
+
+// Handle processes the incoming badge inventory packets.
+func (h *InventoryBadgesHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for badge inventory", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	uid, err := strconv.Atoi(p.Id)
+	if err != nil {
+		h.logger.Error("invalid player identifier for badge inventory", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	badges, err := inventory.Badges(ctx, h.db, uint(uid))
+	if err != nil {
+		h.logger.Error("error loading badge inventory", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	switch pck := raw.(type) {
+
+	case *message.GetBadgesPacket:
+		for _, f := range inventory.BadgeFragments(badges) {
+			conn.SendPacket(f)
+		}
+		conn.SendPacket(&message.WornBadgesPacket{UserId: int32(uid), Badges: inventory.Worn(badges)})
+
+	case *message.WearBadgesPacket:
+		slots := inventory.Slots(badges, pck.Badges)
+		if err := inventory.Wear(ctx, h.db, uint(uid), slots); err != nil {
+			h.logger.Error("error wearing badges", zap.String("identifier", conn.Identifier()), zap.Error(err))
+			return
+		}
+
+		for n := range badges {
+			badges[n].Slot = slots[badges[n].Code]
+		}
+
+		worn := &message.WornBadgesPacket{UserId: int32(uid), Badges: inventory.Worn(badges)}
+		r, err := room.GetUserRoom(ctx, h.roomStore, p)
+		if err != nil || r == nil || !r.IsOnline(p) {
+			conn.SendPacket(worn)
+			return
+		}
+
+		r.Broadcast(worn)
+
+	default:
+		h.logger.Error("cannot cast badge inventory packet, skipping processing")
+	}
+
+}
+
+// NewInventoryBadges creates a new handler instance.
+func NewInventoryBadges() *InventoryBadgesHandler {
+	return &InventoryBadgesHandler{
+		logger:    server.GetServer().Logger(),
+		db:        server.GetServer().Database(),
+		roomStore: server.GetServer().RoomStore(),
+		userStore: server.GetServer().UserStore(),
+	}
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/core/config"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/inventory/message"
+	"pixels-emulator/user"
+)
+
+// InventoryFurnitureHandler sends the furniture kept by the players, loading
+// their inventory on the first request.
+type InventoryFurnitureHandler struct {
+	logger    *zap.Logger    // logger for packet processing details.
+	db        *gorm.DB       // db is the database to load the inventories.
+	userStore user.Store     // userStore is the user store to check user related conn.
+	cfg       *config.Config // cfg is the configuration to check the inventories capacity.
+}
+
+// Handle processes the incoming furniture inventory packet.
+func (h *InventoryFurnitureHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	if _, ok := raw.(*message.GetFurniturePacket); !ok {
+		h.logger.Error("cannot cast furniture inventory packet, skipping processing")
+		return
+	}
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for furniture inventory", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	inv, err := p.Inventory(ctx, h.db, int(h.cfg.Inventory.MaxItems))
+	if err != nil {
+		h.logger.Error("error loading furniture inventory", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if rejected := inv.Rejected(); len(rejected) > 0 {
+		h.logger.Warn("inventory items with unknown definition rejected", zap.String("identifier", conn.Identifier()), zap.Uints("items", rejected))
+	}
+
+	for _, f := range inv.Fragments() {
+		conn.SendPacket(f)
+	}
+
+}
+
+// NewInventoryFurniture creates a new handler instance.
+func NewInventoryFurniture() *InventoryFurnitureHandler {
+	return &InventoryFurnitureHandler{
+		logger:    server.GetServer().Logger(),
+		db:        server.GetServer().Database(),
+		userStore: server.GetServer().UserStore(),
+		cfg:       server.GetServer().Config(),
+	}
+}
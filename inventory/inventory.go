@@ -0,0 +1,240 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"pixels-emulator/core/model"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/inventory/encode"
+	"pixels-emulator/inventory/message"
+	"sort"
+	"sync"
+)
+
+// FragmentSize defines the items sent on each fragment of the inventory.
+const FragmentSize = 1000
+
+var (
+	ErrItemNotFound  = errors.New("item not found in the inventory") // ErrItemNotFound rejects items not kept by the user.
+	ErrInventoryFull = errors.New("inventory is full")               // ErrInventoryFull rejects items over the inventory capacity.
+)
+
+// Inventory defines the items kept by a user, loaded in memory
+// while the user is online.
+type Inventory struct {
+	User     uint // User is the identifier of the inventory owner.
+	Capacity int  // Capacity is the amount of items the user can keep, unlimited if zero.
+
+	mu       sync.RWMutex                  // mu guards the items.
+	items    map[uint]*model.InventoryItem // items are the kept items by identifier.
+	rejected []uint                        // rejected are the items skipped on load.
+}
+
+// New creates an empty inventory of a user.
+func New(user uint, capacity int) *Inventory {
+	return &Inventory{User: user, Capacity: capacity, items: make(map[uint]*model.InventoryItem)}
+}
+
+// Load provides the inventory of a user with the stored items. Items whose
+// definition does not exist are rejected and kept apart from the inventory.
+func Load(ctx context.Context, db *gorm.DB, user uint, capacity int) (*Inventory, error) {
+
+	var items []*model.InventoryItem
+	err := db.WithContext(ctx).Preload("Definition").Preload("User").
+		Where("user_id = ?", user).Order("id").Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	inv := New(user, capacity)
+	for _, i := range items {
+		if i.Definition.ID == 0 {
+			inv.rejected = append(inv.rejected, i.ID)
+			continue
+		}
+		inv.items[i.ID] = i
+	}
+	return inv, nil
+
+}
+
+// Count provides the amount of items stored for a user.
+func Count(ctx context.Context, db *gorm.DB, user uint) (int, error) {
+	var n int64
+	err := db.WithContext(ctx).Model(&model.InventoryItem{}).Where("user_id = ?", user).Count(&n).Error
+	return int(n), err
+}
+
+// Fits checks a user can receive more items. The loaded inventory is used if
+// provided, otherwise the stored items are counted against the capacity.
+func Fits(ctx context.Context, db *gorm.DB, inv *Inventory, user uint, capacity, n int) error {
+
+	if inv != nil {
+		return inv.fits(n)
+	}
+
+	if capacity <= 0 {
+		return nil
+	}
+
+	c, err := Count(ctx, db, user)
+	if err != nil {
+		return err
+	}
+	if c+n > capacity {
+		return ErrInventoryFull
+	}
+	return nil
+
+}
+
+// Items provides the kept items sorted by identifier.
+func (inv *Inventory) Items() []*model.InventoryItem {
+	inv.mu.RLock()
+	defer inv.mu.RUnlock()
+	items := make([]*model.InventoryItem, 0, len(inv.items))
+	for _, i := range inv.items {
+		items = append(items, i)
+	}
+	sort.Slice(items, func(a, b int) bool { return items[a].ID < items[b].ID })
+	return items
+}
+
+// Item provides a kept item.
+func (inv *Inventory) Item(id uint) (*model.InventoryItem, error) {
+	inv.mu.RLock()
+	defer inv.mu.RUnlock()
+	i, ok := inv.items[id]
+	if !ok {
+		return nil, ErrItemNotFound
+	}
+	return i, nil
+}
+
+// Len provides the amount of kept items.
+func (inv *Inventory) Len() int {
+	inv.mu.RLock()
+	defer inv.mu.RUnlock()
+	return len(inv.items)
+}
+
+// Rejected provides the identifiers of the items skipped on load because of an unknown definition.
+func (inv *Inventory) Rejected() []uint {
+	return inv.rejected
+}
+
+// Add keeps the items, unless they exceed the capacity.
+func (inv *Inventory) Add(items ...*model.InventoryItem) error {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	if inv.Capacity > 0 && len(inv.items)+len(items) > inv.Capacity {
+		return ErrInventoryFull
+	}
+	for _, i := range items {
+		inv.items[i.ID] = i
+	}
+	return nil
+}
+
+// Remove takes an item out of the inventory.
+func (inv *Inventory) Remove(id uint) (*model.InventoryItem, error) {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	i, ok := inv.items[id]
+	if !ok {
+		return nil, ErrItemNotFound
+	}
+	delete(inv.items, id)
+	return i, nil
+}
+
+// Push keeps the items and notifies them to the user.
+func (inv *Inventory) Push(conn protocol.Connection, items ...*model.InventoryItem) error {
+	if err := inv.Add(items...); err != nil {
+		return err
+	}
+	for _, i := range items {
+		conn.SendPacket(&message.FurnitureAddPacket{Item: Encode(i)})
+	}
+	return nil
+}
+
+// Pull takes an item out of the inventory and notifies it to the user.
+func (inv *Inventory) Pull(conn protocol.Connection, id uint) (*model.InventoryItem, error) {
+	i, err := inv.Remove(id)
+	if err != nil {
+		return nil, err
+	}
+	conn.SendPacket(&message.FurnitureRemovePacket{ItemId: int32(id)})
+	return i, nil
+}
+
+// Fragments provides the packets of the inventory, split as Nitro expects.
+// An empty inventory is sent as a single empty fragment.
+func (inv *Inventory) Fragments() []*message.FurniturePacket {
+
+	items := inv.Items()
+	total := max(1, (len(items)+FragmentSize-1)/FragmentSize)
+
+	res := make([]*message.FurniturePacket, total)
+	for f := range res {
+		chunk := items[min(f*FragmentSize, len(items)):min((f+1)*FragmentSize, len(items))]
+		enc := make([]encode.InventoryItem, len(chunk))
+		for n, i := range chunk {
+			enc[n] = Encode(i)
+		}
+		res[f] = &message.FurniturePacket{Total: int32(total), Fragment: int32(f), Items: enc}
+	}
+	return res
+
+}
+
+// fits checks the inventory can keep more items.
+func (inv *Inventory) fits(n int) error {
+	if inv.Capacity > 0 && inv.Len()+n > inv.Capacity {
+		return ErrInventoryFull
+	}
+	return nil
+}
+
+// Encode provides the protocol version of an inventory item. Limited items are
+// not grouped, as each of them has its own serial.
+func Encode(i *model.InventoryItem) encode.InventoryItem {
+
+	t := encode.FloorType
+	if i.Definition.Type == model.WallItem {
+		t = encode.WallType
+	}
+
+	return encode.InventoryItem{
+		ItemId:     int32(i.ID),
+		Type:       t,
+		Ref:        int32(i.ID),
+		Sprite:     int32(i.Definition.SpriteID),
+		Category:   category(&i.Definition),
+		Data:       i.ExtraData,
+		Serial:     int32(i.LimitedNumber),
+		Series:     int32(i.LimitedStack),
+		Tradable:   true,
+		Groupable:  i.LimitedStack == 0,
+		Expiration: encode.NoExpiration,
+		RoomId:     encode.NoRoom,
+	}
+
+}
+
+// category provides how the client displays a kind of furniture.
+func category(d *model.ItemDefinition) encode.Category {
+	switch d.Interaction {
+	case "wallpaper":
+		return encode.CategoryWallpaper
+	case "floor":
+		return encode.CategoryFloor
+	case "landscape":
+		return encode.CategoryLandscape
+	case "poster":
+		return encode.CategoryPoster
+	}
+	return encode.CategoryDefault
+}
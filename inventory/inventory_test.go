@@ -0,0 +1,79 @@
+package inventory
+
+import (
+	"pixels-emulator/core/model"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// kept creates an inventory item of a kind.
+func kept(id uint, d model.ItemDefinition) *model.InventoryItem {
+	i := &model.InventoryItem{Definition: d}
+	i.ID = id
+	return i
+}
+
+// TestInventory_Add verifies the capacity is respected for every added item.
+func TestInventory_Add(t *testing.T) {
+	inv := New(1, 2)
+
+	assert.NoError(t, inv.Add(kept(1, model.ItemDefinition{})))
+	assert.ErrorIs(t, inv.Add(kept(2, model.ItemDefinition{}), kept(3, model.ItemDefinition{})), ErrInventoryFull)
+	assert.Equal(t, 1, inv.Len(), "items over the capacity are not added at all")
+
+	assert.NoError(t, inv.Add(kept(2, model.ItemDefinition{})))
+	assert.ErrorIs(t, inv.fits(1), ErrInventoryFull)
+
+	i, err := inv.Remove(1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), i.ID)
+	_, err = inv.Remove(1)
+	assert.ErrorIs(t, err, ErrItemNotFound)
+
+	assert.NoError(t, New(1, 0).Add(kept(1, model.ItemDefinition{}), kept(2, model.ItemDefinition{})), "zero capacity is unlimited")
+}
+
+// TestInventory_Fragments verifies the items are split in sorted fragments.
+func TestInventory_Fragments(t *testing.T) {
+	inv := New(1, 0)
+
+	f := inv.Fragments()
+	assert.Len(t, f, 1, "empty inventories send a single fragment")
+	assert.Equal(t, int32(1), f[0].Total)
+	assert.Empty(t, f[0].Items)
+
+	for id := uint(FragmentSize + 1); id > 0; id-- {
+		assert.NoError(t, inv.Add(kept(id, model.ItemDefinition{})))
+	}
+
+	f = inv.Fragments()
+	assert.Len(t, f, 2)
+	assert.Len(t, f[0].Items, FragmentSize)
+	assert.Len(t, f[1].Items, 1)
+	assert.Equal(t, int32(2), f[1].Total)
+	assert.Equal(t, int32(1), f[1].Fragment)
+	assert.Equal(t, int32(1), f[0].Items[0].ItemId)
+	assert.Equal(t, int32(FragmentSize+1), f[1].Items[0].ItemId)
+}
+
+// TestEncode verifies the type and category of the items.
+func TestEncode(t *testing.T) {
+	chair := Encode(kept(1, model.ItemDefinition{Type: model.FloorItem, SpriteID: 13}))
+	assert.Equal(t, "S", chair.Type)
+	assert.Equal(t, int32(13), chair.Sprite)
+	assert.Equal(t, int32(1), chair.Ref)
+
+	assert.True(t, chair.Groupable)
+
+	limited := kept(3, model.ItemDefinition{Type: model.FloorItem})
+	limited.LimitedNumber, limited.LimitedStack = 7, 100
+	enc := Encode(limited)
+	assert.Equal(t, int32(7), enc.Serial)
+	assert.Equal(t, int32(100), enc.Series)
+	assert.False(t, enc.Groupable, "limited items are not grouped")
+
+	poster := Encode(kept(2, model.ItemDefinition{Type: model.WallItem, Interaction: "poster"}))
+	assert.Equal(t, "I", poster.Type)
+	assert.Equal(t, int32(6), int32(poster.Category))
+}
@@ -0,0 +1,59 @@
+package listener
+
+import (
+	"context"
+	"go.uber.org/zap"
+	authEvent "pixels-emulator/auth/event"
+	"pixels-emulator/core/event"
+	"pixels-emulator/core/server"
+	"pixels-emulator/inventory"
+	"pixels-emulator/inventory/message"
+	"strconv"
+	"time"
+)
+
+// ProvideEffects sends the avatar effects to the users once logged in.
+// It should handle the authentication event after the login is granted.
+func ProvideEffects() func(event event.Event) {
+	return func(event event.Event) {
+		OnEffects(event)
+	}
+}
+
+// OnEffects sends the avatar effects kept by a logged user.
+func OnEffects(ev event.Event) {
+
+	authEv, valid := ev.(*authEvent.AuthGrantEvent)
+	if !valid {
+		server.GetServer().Logger().Error("event proportioned was not authentication, skipping")
+		return
+	}
+
+	if authEv.IsCancelled() {
+		return
+	}
+
+	sv := server.GetServer()
+	var err error
+	defer func() {
+		if err != nil {
+			sv.Logger().Error("error sending avatar effects", zap.Int("user", authEv.UserID()), zap.Error(err))
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	p, err := sv.UserStore().Records().Read(ctx, strconv.Itoa(authEv.UserID()))
+	if err != nil {
+		return
+	}
+
+	effects, err := inventory.Effects(ctx, sv.Database(), uint(authEv.UserID()))
+	if err != nil {
+		return
+	}
+
+	p.Conn().SendPacket(&message.EffectsPacket{Effects: inventory.EncodeEffects(effects, time.Now())})
+
+}
@@ -0,0 +1,45 @@
+package message
+
+import (
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/inventory/encode"
+)
+
+// BadgesCode is the unique identifier for the packet
+const BadgesCode = 717
+
+// BadgesPacket provides a fragment of the badges earned by the user.
+// Nitro merges every fragment before displaying the badges.
+type BadgesPacket struct {
+	Total    int32          // Total is the amount of fragments of the badges.
+	Fragment int32          // Fragment is the index of the current fragment.
+	Badges   []encode.Badge // Badges are the badges of the fragment.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *BadgesPacket) Id() uint16 {
+	return BadgesCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *BadgesPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *BadgesPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *BadgesPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(BadgesCode)
+	pck.AddInt(p.Total)
+	pck.AddInt(p.Fragment)
+	pck.AddInt(int32(len(p.Badges)))
+	for _, b := range p.Badges {
+		b.Encode(&pck)
+	}
+	return pck
+}
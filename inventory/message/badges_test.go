@@ -0,0 +1,38 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/inventory/encode"
+)
+
+// TestBadgesPacket_Serialize verifies the packet serialization.
+func TestBadgesPacket_Serialize(t *testing.T) {
+	pck := &BadgesPacket{Total: 2, Fragment: 1, Badges: []encode.Badge{{BadgeId: 3, Code: "ADM"}}}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	total, _ := raw.ReadInt()
+	fragment, _ := raw.ReadInt()
+	n, _ := raw.ReadInt()
+	assert.Equal(t, int32(2), total)
+	assert.Equal(t, int32(1), fragment)
+	assert.Equal(t, int32(1), n)
+
+	badge := encode.Badge{}
+	assert.NoError(t, badge.Decode(raw))
+	assert.Equal(t, pck.Badges[0], badge)
+}
+
+// TestBadgesPacket_Integrity tests packet ID, deadline, and rate.
+func TestBadgesPacket_Integrity(t *testing.T) {
+	pck := &BadgesPacket{}
+	assert.Equal(t, uint16(BadgesCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,40 @@
+package message
+
+import (
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/inventory/encode"
+)
+
+// EffectsCode is the unique identifier for the packet
+const EffectsCode = 340
+
+// EffectsPacket provides the avatar effects kept by the user.
+type EffectsPacket struct {
+	Effects []encode.Effect // Effects are the kept avatar effects.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *EffectsPacket) Id() uint16 {
+	return EffectsCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *EffectsPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *EffectsPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *EffectsPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(EffectsCode)
+	pck.AddInt(int32(len(p.Effects)))
+	for _, e := range p.Effects {
+		e.Encode(&pck)
+	}
+	return pck
+}
@@ -0,0 +1,34 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/inventory/encode"
+)
+
+// TestEffectsPacket_Serialize verifies the packet serialization.
+func TestEffectsPacket_Serialize(t *testing.T) {
+	pck := &EffectsPacket{Effects: []encode.Effect{{Type: 4, Duration: 3600, Inactive: 1, SecondsLeft: encode.NotActive}}}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	n, _ := raw.ReadInt()
+	assert.Equal(t, int32(1), n)
+
+	effect := encode.Effect{}
+	assert.NoError(t, effect.Decode(raw))
+	assert.Equal(t, pck.Effects[0], effect)
+}
+
+// TestEffectsPacket_Integrity tests packet ID, deadline, and rate.
+func TestEffectsPacket_Integrity(t *testing.T) {
+	pck := &EffectsPacket{}
+	assert.Equal(t, uint16(EffectsCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
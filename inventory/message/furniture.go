@@ -0,0 +1,45 @@
+package message
+
+import (
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/inventory/encode"
+)
+
+// FurnitureCode is the unique identifier for the packet
+const FurnitureCode = 994
+
+// FurniturePacket provides a fragment of the furniture kept in the user inventory.
+// Nitro merges every fragment before displaying the inventory.
+type FurniturePacket struct {
+	Total    int32                  // Total is the amount of fragments of the inventory.
+	Fragment int32                  // Fragment is the index of the current fragment.
+	Items    []encode.InventoryItem // Items are the items of the fragment.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *FurniturePacket) Id() uint16 {
+	return FurnitureCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *FurniturePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *FurniturePacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *FurniturePacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(FurnitureCode)
+	pck.AddInt(p.Total)
+	pck.AddInt(p.Fragment)
+	pck.AddInt(int32(len(p.Items)))
+	for _, i := range p.Items {
+		i.Encode(&pck)
+	}
+	return pck
+}
@@ -0,0 +1,37 @@
+package message
+
+import (
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/inventory/encode"
+)
+
+// FurnitureAddCode is the unique identifier for the packet
+const FurnitureAddCode = 104
+
+// FurnitureAddPacket notifies an item added to the user inventory, or updated if already kept.
+type FurnitureAddPacket struct {
+	Item encode.InventoryItem // Item is the added item.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *FurnitureAddPacket) Id() uint16 {
+	return FurnitureAddCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *FurnitureAddPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *FurnitureAddPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *FurnitureAddPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(FurnitureAddCode)
+	p.Item.Encode(&pck)
+	return pck
+}
@@ -0,0 +1,31 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/inventory/encode"
+)
+
+// TestFurnitureAddPacket_Serialize verifies the packet serialization.
+func TestFurnitureAddPacket_Serialize(t *testing.T) {
+	pck := &FurnitureAddPacket{Item: encode.InventoryItem{ItemId: 4, Type: encode.WallType, Ref: 4, Sprite: 4001, Category: encode.CategoryPoster, Expiration: encode.NoExpiration, RoomId: encode.NoRoom}}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	item := encode.InventoryItem{}
+	assert.NoError(t, item.Decode(raw))
+	assert.Equal(t, pck.Item, item)
+}
+
+// TestFurnitureAddPacket_Integrity tests packet ID, deadline, and rate.
+func TestFurnitureAddPacket_Integrity(t *testing.T) {
+	pck := &FurnitureAddPacket{}
+	assert.Equal(t, uint16(FurnitureAddCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
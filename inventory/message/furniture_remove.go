@@ -0,0 +1,34 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// FurnitureRemoveCode is the unique identifier for the packet
+const FurnitureRemoveCode = 159
+
+// FurnitureRemovePacket notifies an item removed from the user inventory.
+type FurnitureRemovePacket struct {
+	ItemId int32 // ItemId is the identifier of the removed item.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *FurnitureRemovePacket) Id() uint16 {
+	return FurnitureRemoveCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *FurnitureRemovePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *FurnitureRemovePacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *FurnitureRemovePacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(FurnitureRemoveCode)
+	pck.AddInt(p.ItemId)
+	return pck
+}
@@ -0,0 +1,31 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestFurnitureRemovePacket_Serialize verifies the packet serialization.
+func TestFurnitureRemovePacket_Serialize(t *testing.T) {
+	pck := &FurnitureRemovePacket{ItemId: int32(1)}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(FurnitureRemoveCode), raw.GetHeader())
+
+	i0, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), i0)
+}
+
+// TestFurnitureRemovePacket_Integrity tests packet ID, deadline, and rate.
+func TestFurnitureRemovePacket_Integrity(t *testing.T) {
+	pck := &FurnitureRemovePacket{}
+	assert.Equal(t, uint16(FurnitureRemoveCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
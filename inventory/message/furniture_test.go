@@ -0,0 +1,42 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/inventory/encode"
+)
+
+// TestFurniturePacket_Serialize verifies the packet serialization.
+func TestFurniturePacket_Serialize(t *testing.T) {
+	pck := &FurniturePacket{
+		Total:    2,
+		Fragment: 1,
+		Items:    []encode.InventoryItem{{ItemId: 3, Type: encode.FloorType, Ref: 3, Sprite: 13, Category: encode.CategoryDefault, Expiration: encode.NoExpiration, RoomId: encode.NoRoom}},
+	}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	total, _ := raw.ReadInt()
+	fragment, _ := raw.ReadInt()
+	n, _ := raw.ReadInt()
+	assert.Equal(t, int32(2), total)
+	assert.Equal(t, int32(1), fragment)
+	assert.Equal(t, int32(1), n)
+
+	item := encode.InventoryItem{}
+	assert.NoError(t, item.Decode(raw))
+	assert.Equal(t, pck.Items[0], item)
+}
+
+// TestFurniturePacket_Integrity tests packet ID, deadline, and rate.
+func TestFurniturePacket_Integrity(t *testing.T) {
+	pck := &FurniturePacket{}
+	assert.Equal(t, uint16(FurnitureCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,31 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// GetBadgesCode is the unique identifier for the packet
+const GetBadgesCode = 2769
+
+// GetBadgesPacket requests the badges earned by the user.
+type GetBadgesPacket struct {
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *GetBadgesPacket) Id() uint16 {
+	return GetBadgesCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *GetBadgesPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *GetBadgesPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeGetBadgesPacket composes a new instance of the packet.
+func ComposeGetBadgesPacket(_ protocol.RawPacket) (*GetBadgesPacket, error) {
+	return &GetBadgesPacket{}, nil
+}
@@ -0,0 +1,25 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeGetBadgesPacket verifies the packet is composed without content.
+func TestComposeGetBadgesPacket(t *testing.T) {
+	pck, err := ComposeGetBadgesPacket(protocol.NewPacket(GetBadgesCode))
+	assert.NoError(t, err)
+	assert.NotNil(t, pck)
+}
+
+// TestGetBadgesPacket_Integrity tests packet ID, deadline, and rate.
+func TestGetBadgesPacket_Integrity(t *testing.T) {
+	pck := &GetBadgesPacket{}
+	assert.Equal(t, uint16(GetBadgesCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,31 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// GetFurnitureCode is the unique identifier for the packet
+const GetFurnitureCode = 3150
+
+// GetFurniturePacket requests the furniture kept in the user inventory.
+type GetFurniturePacket struct {
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *GetFurniturePacket) Id() uint16 {
+	return GetFurnitureCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *GetFurniturePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *GetFurniturePacket) Deadline() uint {
+	return 500
+}
+
+// ComposeGetFurniturePacket composes a new instance of the packet.
+func ComposeGetFurniturePacket(_ protocol.RawPacket) (*GetFurniturePacket, error) {
+	return &GetFurniturePacket{}, nil
+}
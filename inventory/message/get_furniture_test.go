@@ -0,0 +1,25 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeGetFurniturePacket verifies the packet is composed without content.
+func TestComposeGetFurniturePacket(t *testing.T) {
+	pck, err := ComposeGetFurniturePacket(protocol.NewPacket(GetFurnitureCode))
+	assert.NoError(t, err)
+	assert.NotNil(t, pck)
+}
+
+// TestGetFurniturePacket_Integrity tests packet ID, deadline, and rate.
+func TestGetFurniturePacket_Integrity(t *testing.T) {
+	pck := &GetFurniturePacket{}
+	assert.Equal(t, uint16(GetFurnitureCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
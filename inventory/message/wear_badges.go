@@ -0,0 +1,58 @@
+package message
+
+import (
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/inventory/encode"
+)
+
+// WearBadgesCode is the unique identifier for the packet
+const WearBadgesCode = 644
+
+// WornSlots defines the amount of badges a user can wear.
+const WornSlots = 5
+
+// WearBadgesPacket requests the badges worn on each slot of the user profile.
+type WearBadgesPacket struct {
+	Badges []encode.WornBadge // Badges are the requested badges by slot, with empty codes on free slots.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *WearBadgesPacket) Id() uint16 {
+	return WearBadgesCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *WearBadgesPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *WearBadgesPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeWearBadgesPacket composes a new instance of the packet.
+// Nitro sends every slot, although fewer slots are accepted.
+func ComposeWearBadgesPacket(pck protocol.RawPacket) (*WearBadgesPacket, error) {
+
+	var badges []encode.WornBadge
+	for len(badges) < WornSlots {
+
+		s, err := pck.ReadInt()
+		if err != nil {
+			break
+		}
+
+		c, err := pck.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		badges = append(badges, encode.WornBadge{Slot: s, Code: c})
+
+	}
+
+	return &WearBadgesPacket{Badges: badges}, nil
+
+}
@@ -0,0 +1,43 @@
+package message
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/inventory/encode"
+)
+
+// TestComposeWearBadgesPacket verifies the packet is composed from its content.
+func TestComposeWearBadgesPacket(t *testing.T) {
+	raw := protocol.NewPacket(WearBadgesCode)
+	for s := int32(1); s <= WornSlots+1; s++ {
+		raw.AddInt(s)
+		raw.AddString("value" + strconv.Itoa(int(s)))
+	}
+
+	pck, err := ComposeWearBadgesPacket(raw)
+	assert.NoError(t, err)
+	assert.Len(t, pck.Badges, WornSlots, "slots over the limit are ignored")
+	assert.Equal(t, encode.WornBadge{Slot: 1, Code: "value1"}, pck.Badges[0])
+
+	pck, err = ComposeWearBadgesPacket(protocol.NewPacket(WearBadgesCode))
+	assert.NoError(t, err)
+	assert.Empty(t, pck.Badges)
+
+	raw = protocol.NewPacket(WearBadgesCode)
+	raw.AddInt(1)
+	_, err = ComposeWearBadgesPacket(raw)
+	assert.Error(t, err)
+}
+
+// TestWearBadgesPacket_Integrity tests packet ID, deadline, and rate.
+func TestWearBadgesPacket_Integrity(t *testing.T) {
+	pck := &WearBadgesPacket{}
+	assert.Equal(t, uint16(WearBadgesCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
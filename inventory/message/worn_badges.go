@@ -0,0 +1,42 @@
+package message
+
+import (
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/inventory/encode"
+)
+
+// WornBadgesCode is the unique identifier for the packet
+const WornBadgesCode = 1087
+
+// WornBadgesPacket provides the badges worn by a user on its profile.
+type WornBadgesPacket struct {
+	UserId int32              // UserId is the identifier of the user wearing the badges.
+	Badges []encode.WornBadge // Badges are the worn badges by slot.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *WornBadgesPacket) Id() uint16 {
+	return WornBadgesCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *WornBadgesPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *WornBadgesPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *WornBadgesPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(WornBadgesCode)
+	pck.AddInt(p.UserId)
+	pck.AddInt(int32(len(p.Badges)))
+	for _, b := range p.Badges {
+		b.Encode(&pck)
+	}
+	return pck
+}
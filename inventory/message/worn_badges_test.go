@@ -0,0 +1,38 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/inventory/encode"
+)
+
+// TestWornBadgesPacket_Serialize verifies the packet serialization.
+func TestWornBadgesPacket_Serialize(t *testing.T) {
+	pck := &WornBadgesPacket{UserId: 4, Badges: []encode.WornBadge{{Slot: 1, Code: "ADM"}, {Slot: 3, Code: "HC1"}}}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	user, _ := raw.ReadInt()
+	n, _ := raw.ReadInt()
+	assert.Equal(t, int32(4), user)
+	assert.Equal(t, int32(2), n)
+
+	for _, expected := range pck.Badges {
+		badge := encode.WornBadge{}
+		assert.NoError(t, badge.Decode(raw))
+		assert.Equal(t, expected, badge)
+	}
+}
+
+// TestWornBadgesPacket_Integrity tests packet ID, deadline, and rate.
+func TestWornBadgesPacket_Integrity(t *testing.T) {
+	pck := &WornBadgesPacket{}
+	assert.Equal(t, uint16(WornBadgesCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"go.uber.org/zap"
 	"net"
 	"os"
@@ -8,8 +9,10 @@ import (
 	"pixels-emulator/core/ephemeral"
 	"pixels-emulator/core/server"
 	"pixels-emulator/core/setup"
+	"pixels-emulator/room/chatlog"
 	"strconv"
 	"syscall"
+	"time"
 )
 
 // main initializes the server, binds it, and handles system signals for graceful shutdown.
@@ -20,6 +23,7 @@ func main() {
 	ephemeral.Handlers()
 	ephemeral.Cron()
 	ephemeral.Event()
+	ephemeral.Filters()
 
 	// Channel to listen for system termination signals
 	sigChannel := make(chan os.Signal, 1)
@@ -41,6 +45,12 @@ func main() {
 	if err := sv.Stop(); err != nil {
 		sv.Logger().Error("Error while stopping server", zap.Error(err))
 	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := chatlog.GetWriter().Flush(ctx); err != nil {
+		sv.Logger().Error("Error while writing pending chat logs", zap.Error(err))
+	}
 	sv.Logger().Info("Server stopped gracefully")
 }
 
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"pixels-emulator/core/model"
+	"pixels-emulator/room"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	Popular    = "popular"        // Popular provides the rooms with players in-game.
+	My         = "my"             // My provides the rooms owned by the user.
+	Rights     = "with_rights"    // Rights provides the rooms where the user has rights.
+	History    = "history"        // History provides the rooms recently visited by the user.
+	Official   = "official-root"  // Official provides the public rooms of the hotel.
+	TopRated   = "highest_score"  // TopRated provides the rooms with the highest score.
+	Promotions = "top_promotions" // Promotions provides the rooms with an active promotion.
+)
+
+// CategoryPrefix prefixes the filters of the rooms assigned to each category.
+const CategoryPrefix = "category-"
+
+// RegisterBuiltin adds the filters used by the default navigator displays.
+// Popular rooms are selected from the live rooms of the store, by population.
+func RegisterBuiltin(rs room.Store) {
+
+	RegisterFilter(Popular, func(db *gorm.DB, s *Search) *gorm.DB {
+		ids := s.Active
+		if ids == nil {
+			ids = Active(db.Statement.Context, rs)
+			db = Activity(db, ids)
+		}
+		if len(ids) == 0 {
+			return db.Where("1 = 0")
+		}
+		return db.Where("rooms.id IN ?", ids)
+	})
+
+	RegisterFilter(My, func(db *gorm.DB, s *Search) *gorm.DB {
+		return db.Where("rooms.owner_id = ?", s.User).Order("rooms.id DESC")
+	})
+
+	RegisterFilter(Rights, func(db *gorm.DB, s *Search) *gorm.DB {
+		perms := db.Session(&gorm.Session{NewDB: true}).Model(&model.RoomPermission{}).Select("room_id").Where("user_id = ?", s.User)
+		return db.Where("rooms.id IN (?)", perms)
+	})
+
+	RegisterFilter(History, func(db *gorm.DB, s *Search) *gorm.DB {
+		return db.Joins("JOIN room_visits ON room_visits.room_id = rooms.id AND room_visits.user_id = ? AND room_visits.deleted_at IS NULL", s.User).
+			Order("room_visits.updated_at DESC")
+	})
+
+	RegisterFilter(TopRated, func(db *gorm.DB, _ *Search) *gorm.DB {
+		return db.Where("rooms.score > 0").Order("rooms.score DESC")
+	})
+
+	RegisterFilter(Official, func(db *gorm.DB, _ *Search) *gorm.DB {
+		return db.Where("rooms.is_public = ?", true)
+	})
+
+	RegisterFilter(Promotions, func(db *gorm.DB, _ *Search) *gorm.DB {
+		return db.Joins("JOIN room_promotions ON room_promotions.room_id = rooms.id AND room_promotions.expires > ? AND room_promotions.deleted_at IS NULL", time.Now()).
+			Order("room_promotions.created_at DESC")
+	})
+
+}
+
+// Category provides the filter of the rooms assigned to a category.
+func Category(id uint) string {
+	return CategoryPrefix + strconv.Itoa(int(id))
+}
+
+// categoryFilter resolves the filter of the rooms assigned to the category
+// prefixed on the identifier, so categories created at runtime are filtered too.
+func categoryFilter(id string) (RoomFilterHandler, bool) {
+	raw, found := strings.CutPrefix(id, CategoryPrefix)
+	if !found {
+		return RoomFilterHandler{}, false
+	}
+
+	cat, err := strconv.ParseUint(raw, 10, 32)
+	if err != nil {
+		return RoomFilterHandler{}, false
+	}
+
+	return RoomFilterHandler{
+		ID: id,
+		FilterFunc: func(db *gorm.DB, _ *Search) *gorm.DB {
+			return db.Where("rooms.category_id = ?", uint(cat))
+		},
+	}, true
+}
+
+// Active provides the identifiers of the loaded rooms with players in-game,
+// the most populated first.
+func Active(ctx context.Context, rs room.Store) []uint {
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	rooms, err := rs.Records().GetAll(ctx)
+	if err != nil {
+		return nil
+	}
+
+	population := make(map[uint]int, len(rooms))
+	ids := make([]uint, 0, len(rooms))
+	for _, r := range rooms {
+		if n := r.Population(); n > 0 {
+			population[r.Id] = n
+			ids = append(ids, r.Id)
+		}
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		if population[ids[i]] != population[ids[j]] {
+			return population[ids[i]] > population[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	return ids
+
+}
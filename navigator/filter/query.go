@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"pixels-emulator/room"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+const (
+	OwnerPrefix  = "owner"    // OwnerPrefix searches the rooms of a user by its exact name.
+	TagPrefix    = "tag"      // TagPrefix searches the rooms with exactly a tag.
+	NamePrefix   = "roomname" // NamePrefix searches the rooms by their name.
+	GroupPrefix  = "group"    // GroupPrefix searches the rooms of a group by its name.
+	FreeText     = "query"    // FreeText searches without prefix by room name, owner or tag.
+	OffsetPrefix = "offset"   // OffsetPrefix skips an amount of rooms, paging the results.
+)
+
+// Offset provides the amount of rooms skipped by the query, zero if not set or invalid.
+func Offset(query map[string]string) int {
+	n, err := strconv.Atoi(strings.TrimSpace(query[OffsetPrefix]))
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
+// Searching checks if the query restricts the rooms, besides paging them.
+func Searching(query map[string]string) bool {
+	for k, v := range query {
+		if k != OffsetPrefix && strings.TrimSpace(v) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyQuery restricts the statement to the rooms matching the navigator query prefixes.
+func ApplyQuery(db *gorm.DB, query map[string]string) *gorm.DB {
+
+	for k, v := range query {
+
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		switch k {
+		case OwnerPrefix:
+			db = db.Where("rooms.owner_id IN (?)", db.Session(&gorm.Session{NewDB: true}).Table("users").Select("id").Where("username = ?", v))
+		case TagPrefix:
+			db = db.Where("CONCAT(?, rooms.tags, ?) LIKE ?", room.TagSeparator, room.TagSeparator, tag(v))
+		case NamePrefix:
+			db = db.Where("rooms.name LIKE ?", like(v))
+		case GroupPrefix:
+			// Groups are not available yet, so no room belongs to any.
+			db = db.Where("1 = 0")
+		case FreeText:
+			owners := db.Session(&gorm.Session{NewDB: true}).Table("users").Select("id").Where("username LIKE ?", like(v))
+			db = db.Where("rooms.name LIKE ? OR rooms.tags LIKE ? OR rooms.owner_id IN (?)", like(v), like(v), owners)
+		}
+
+	}
+
+	return db
+
+}
+
+// like escapes the wildcards of a value to be contained in a LIKE pattern.
+func like(v string) string {
+	r := strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_")
+	return "%" + r.Replace(v) + "%"
+}
+
+// tag provides the pattern of the stored tags containing exactly a tag.
+func tag(v string) string {
+	return like(room.TagSeparator + room.NormalizeTag(v) + room.TagSeparator)
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"pixels-emulator/core/model"
+)
+
+// dryRun creates a database session which only builds the statements.
+func dryRun(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	assert.NoError(t, err)
+	return db
+}
+
+// statement builds the query of the rooms matching the navigator query.
+func statement(t *testing.T, query map[string]string) string {
+	var rooms []model.Room
+	return ApplyQuery(dryRun(t).Model(&model.Room{}), query).Find(&rooms).Statement.SQL.String()
+}
+
+// TestApplyQuery verifies each prefix is resolved into its condition.
+func TestApplyQuery(t *testing.T) {
+	assert.Contains(t, statement(t, map[string]string{OwnerPrefix: "john"}), "rooms.owner_id IN (SELECT id FROM `users` WHERE username = ?)")
+	assert.Contains(t, statement(t, map[string]string{TagPrefix: "fun"}), "CONCAT(?, rooms.tags, ?) LIKE ?")
+	assert.Contains(t, statement(t, map[string]string{NamePrefix: "lobby"}), "rooms.name LIKE ?")
+	assert.Contains(t, statement(t, map[string]string{GroupPrefix: "guild"}), "1 = 0")
+	assert.Contains(t, statement(t, map[string]string{FreeText: "lobby"}), "rooms.name LIKE ? OR rooms.tags LIKE ? OR rooms.owner_id IN")
+	assert.NotContains(t, statement(t, map[string]string{NamePrefix: " "}), "LIKE")
+}
+
+// TestOffset verifies the pages are read from the query, which is not searching by them.
+func TestOffset(t *testing.T) {
+	assert.Equal(t, 20, Offset(map[string]string{OffsetPrefix: "20"}))
+	assert.Equal(t, 0, Offset(map[string]string{OffsetPrefix: "-5"}))
+	assert.Equal(t, 0, Offset(map[string]string{OffsetPrefix: "many"}))
+	assert.Equal(t, 0, Offset(map[string]string{FreeText: "lobby"}))
+
+	assert.False(t, Searching(map[string]string{OffsetPrefix: "20"}))
+	assert.False(t, Searching(map[string]string{FreeText: " "}))
+	assert.True(t, Searching(map[string]string{NamePrefix: "lobby"}))
+}
+
+// TestLike verifies the wildcards of the values are escaped.
+func TestLike(t *testing.T) {
+	assert.Equal(t, "%lobby%", like("lobby"))
+	assert.Equal(t, "%100\\%\\_%", like("100%_"))
+}
+
+// TestTag verifies tags are matched normalized and between separators.
+func TestTag(t *testing.T) {
+	assert.Equal(t, "%;role play;%", tag(" Role  Play"))
+}
@@ -2,15 +2,27 @@ package repository
 
 import (
 	model "pixels-emulator/core/model"
+	"pixels-emulator/room"
+	"strconv"
+	"strings"
 	"sync"
 
 	"gorm.io/gorm"
 )
 
+// Search defines the context of a navigator query where filters are applied.
+type Search struct {
+	User   uint              // User is the identifier of the user searching.
+	Query  map[string]string // Query are the parsed parameters of the search.
+	Offset int               // Offset is the amount of rooms skipped.
+	Limit  int               // Limit is the maximum amount of rooms provided, unlimited if zero.
+	Active []uint            // Active are the rooms with players in-game by population, listed first when set.
+}
+
 // RoomFilterHandler handles room filtering logic.
 type RoomFilterHandler struct {
 	ID         string
-	FilterFunc func(*gorm.DB) *gorm.DB
+	FilterFunc func(*gorm.DB, *Search) *gorm.DB
 }
 
 // filters store the needed filters.
@@ -20,7 +32,7 @@ var (
 )
 
 // RegisterFilter adds a new filter dynamically.
-func RegisterFilter(id string, filterFunc func(*gorm.DB) *gorm.DB) {
+func RegisterFilter(id string, filterFunc func(*gorm.DB, *Search) *gorm.DB) {
 	filtersMu.Lock()
 	defer filtersMu.Unlock()
 
@@ -30,27 +42,75 @@ func RegisterFilter(id string, filterFunc func(*gorm.DB) *gorm.DB) {
 	}
 }
 
-// FilterExists checks if a filter is registered.
+// FilterExists checks if a filter is registered or resolved from its prefix.
 func FilterExists(filterID string) bool {
+	_, exists := lookup(filterID)
+	return exists
+}
+
+// lookup provides a registered filter, or the category filter of a prefixed identifier.
+func lookup(filterID string) (RoomFilterHandler, bool) {
 	filtersMu.Lock()
-	defer filtersMu.Unlock()
+	filter, exists := filters[filterID]
+	filtersMu.Unlock()
 
-	_, exists := filters[filterID]
-	return exists
+	if exists {
+		return filter, true
+	}
+	return categoryFilter(filterID)
 }
 
-// GetRoomsByFilter applies the selected filter.
-func GetRoomsByFilter(db *gorm.DB, filterID string) ([]model.Room, error) {
-	if !FilterExists(filterID) {
+// GetRoomsByFilter applies the selected filter along the search query and pagination.
+func GetRoomsByFilter(db *gorm.DB, filterID string, s *Search) ([]model.Room, error) {
+	filter, exists := lookup(filterID)
+	if !exists {
 		return nil, nil
 	}
 
-	filtersMu.Lock()
-	filter := filters[filterID]
-	filtersMu.Unlock()
-
 	var rooms []model.Room
-	db = filter.FilterFunc(db)
+	db = Page(ApplyQuery(filter.FilterFunc(Rooms(db, s), s), s.Query), s)
 	err := db.Find(&rooms).Error
 	return rooms, err
 }
+
+// GetRooms applies the search query and pagination over every room.
+func GetRooms(db *gorm.DB, s *Search) ([]model.Room, error) {
+	var rooms []model.Room
+	err := Page(ApplyQuery(Rooms(db, s), s.Query), s).Find(&rooms).Error
+	return rooms, err
+}
+
+// Rooms provides the base statement of the navigator rooms, with the associations encoded.
+// Invisible rooms are only listed to their owners, and the active rooms are listed first.
+func Rooms(db *gorm.DB, s *Search) *gorm.DB {
+	db = db.Model(&model.Room{}).Preload("Owner").Preload("Configuration").Preload("Promotion").
+		Where("rooms.state <> ? OR rooms.owner_id = ?", room.StateInvisible, s.User)
+	return Activity(db, s.Active)
+}
+
+// Activity orders the rooms by their position in the active ones, so the most
+// populated rooms are paged first and the inactive rooms are left at the end.
+func Activity(db *gorm.DB, active []uint) *gorm.DB {
+	if len(active) == 0 {
+		return db
+	}
+
+	// FIELD is zero for the inactive rooms, so the most populated room goes last.
+	// The identifiers are inlined, as gorm drops bound order expressions when merging later orders.
+	ids := make([]string, len(active))
+	for i, id := range active {
+		ids[len(active)-1-i] = strconv.FormatUint(uint64(id), 10)
+	}
+	return db.Order("FIELD(rooms.id, " + strings.Join(ids, ", ") + ") DESC")
+}
+
+// Page limits the statement to the search pagination.
+func Page(db *gorm.DB, s *Search) *gorm.DB {
+	if s.Offset > 0 {
+		db = db.Offset(s.Offset)
+	}
+	if s.Limit > 0 {
+		db = db.Limit(s.Limit)
+	}
+	return db
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"pixels-emulator/core/model"
+)
+
+// TestGetRoomsByFilter verifies only registered filters are applied.
+func TestGetRoomsByFilter(t *testing.T) {
+	RegisterFilter("test", func(db *gorm.DB, s *Search) *gorm.DB {
+		return db.Where("rooms.owner_id = ?", s.User)
+	})
+	assert.True(t, FilterExists("test"))
+	assert.False(t, FilterExists("unknown"))
+
+	rooms, err := GetRoomsByFilter(dryRun(t), "unknown", &Search{})
+	assert.NoError(t, err)
+	assert.Nil(t, rooms)
+
+	_, err = GetRoomsByFilter(dryRun(t), "test", &Search{User: 1, Limit: 5})
+	assert.NoError(t, err)
+}
+
+// TestRooms verifies invisible rooms are only listed to their owners and pages are limited.
+func TestRooms(t *testing.T) {
+	var rooms []model.Room
+	s := &Search{User: 1, Offset: 10, Limit: 5}
+	sql := Page(Rooms(dryRun(t), s), s).Find(&rooms).Statement.SQL.String()
+	assert.Contains(t, sql, "rooms.state <> ? OR rooms.owner_id = ?")
+	assert.Contains(t, sql, "LIMIT ? OFFSET ?")
+}
+
+// TestActivity verifies the active rooms are ordered by population before paging.
+func TestActivity(t *testing.T) {
+	var rooms []model.Room
+	s := &Search{User: 1, Limit: 11, Active: []uint{7, 3}}
+	sql := Page(Rooms(dryRun(t), s).Order("rooms.score DESC"), s).Find(&rooms).Statement.SQL.String()
+	assert.Contains(t, sql, "ORDER BY FIELD(rooms.id, 3, 7) DESC,rooms.score DESC LIMIT ?", "the most populated room has the highest position")
+
+	sql = Rooms(dryRun(t), &Search{}).Find(&rooms).Statement.SQL.String()
+	assert.NotContains(t, sql, "FIELD")
+}
+
+// TestCategoryFilter verifies category filters are resolved from their prefix.
+func TestCategoryFilter(t *testing.T) {
+	assert.True(t, FilterExists(Category(12)))
+	assert.False(t, FilterExists(CategoryPrefix+"x"))
+	assert.False(t, FilterExists(CategoryPrefix))
+
+	f, ok := lookup(Category(12))
+	assert.True(t, ok)
+
+	var rooms []model.Room
+	stmt := f.FilterFunc(dryRun(t).Model(&model.Room{}), &Search{}).Find(&rooms).Statement
+	assert.Contains(t, stmt.SQL.String(), "rooms.category_id = ?")
+	assert.Equal(t, uint(12), stmt.Vars[0])
+}
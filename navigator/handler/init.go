@@ -3,19 +3,23 @@ package handler
 import (
 	"context"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 	"pixels-emulator/core/protocol"
 	"pixels-emulator/core/server"
 	"pixels-emulator/navigator/message"
+	"pixels-emulator/navigator/preference"
+	"strconv"
 )
 
 // NavigatorInitHandler handles incoming Packet from clients.
 // Replies sending a set of packets
 type NavigatorInitHandler struct {
 	logger *zap.Logger // logger instance for recording packet processing details.
+	db     *gorm.DB    // db is the database to read the user preferences.
 }
 
 // Handle performs logic to handle the packet.
-func (h *NavigatorInitHandler) Handle(_ context.Context, packet protocol.Packet, conn protocol.Connection) {
+func (h *NavigatorInitHandler) Handle(ctx context.Context, packet protocol.Packet, conn protocol.Connection) {
 
 	_, ok := packet.(*message.NavigatorInitPacket)
 	if !ok {
@@ -25,20 +29,56 @@ func (h *NavigatorInitHandler) Handle(_ context.Context, packet protocol.Packet,
 
 	h.logger.Debug("Navigator fired by user", zap.String("identifier", conn.Identifier()))
 
-	// INVESTIGATION: Navigation packets useless in Nitro Client.
-	// Navigation Settings (518): Stores position of window.
-	// Navigator Metadata (3052)
-	// Navigator Lifted (3104)
-	// Navigator Collapsed Categories (1543)
-	// Navigator Searches Event (3984)
-	// In Arcturus, there is a weird and maybe buggy way to query both Event and User categories.
-	// However, when firing this event, it actually sends all the unused packets with only the event categories
-	// It should fire both or just one? I don't know.
-	// Categories are provided via UserInfoEvent and expected to be reach only by a request...
-	// For now this will remain empty while I figure out this usage.
-
+	// INVESTIGATION: Navigator Lifted (3104) and event categories are still not sent,
+	// as there are no promoted rooms to lift yet.
 	navCtx := []string{"official_view", "hotel_view", "roomads_view", "myworld_view"}
-	conn.SendPacket(message.NewNavigatorMetaDataPacket(navCtx...))
+
+	uid, err := strconv.Atoi(conn.Identifier())
+	if err != nil {
+		h.logger.Error("invalid navigator user identifier", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	w, err := preference.Window(ctx, h.db, uint(uid))
+	if err != nil {
+		h.logger.Error("error loading navigator preferences", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	searches, err := preference.Searches(ctx, h.db, uint(uid))
+	if err != nil {
+		h.logger.Error("error loading navigator saved searches", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	cats, err := preference.Categories(ctx, h.db, uint(uid))
+	if err != nil {
+		h.logger.Error("error loading navigator categories", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	var collapsed []string
+	for code, c := range cats {
+		if c.Collapsed {
+			collapsed = append(collapsed, code)
+		}
+	}
+
+	enc := preference.Encode(searches)
+	meta := message.NewNavigatorMetaDataPacket(navCtx...)
+	meta.Searches = enc
+
+	conn.SendPacket(&message.NavigatorSettingsPacket{
+		X:              int32(w.WindowX),
+		Y:              int32(w.WindowY),
+		Width:          int32(w.WindowWidth),
+		Height:         int32(w.WindowHeight),
+		LeftPaneHidden: w.LeftPaneHidden,
+		ResultsMode:    int32(w.ResultsMode),
+	})
+	conn.SendPacket(meta)
+	conn.SendPacket(&message.NavigatorCollapsedPacket{Codes: collapsed})
+	conn.SendPacket(&message.NavigatorSavedSearchesPacket{Searches: enc})
 
 }
 
@@ -46,5 +86,6 @@ func (h *NavigatorInitHandler) Handle(_ context.Context, packet protocol.Packet,
 func NewNavigatorInit() *NavigatorInitHandler {
 	return &NavigatorInitHandler{
 		logger: server.GetServer().Logger(),
+		db:     server.GetServer().Database(),
 	}
 }
@@ -5,6 +5,8 @@ import (
 	"context"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
 	"pixels-emulator/core/protocol"
 	mockproto "pixels-emulator/core/protocol/mock"
 	"pixels-emulator/core/server"
@@ -19,6 +21,8 @@ func setupTestServer() (*mockserver.Server, *bytes.Buffer) {
 	sv := &mockserver.Server{}
 	log, buf := util.CreateTestLogger()
 	sv.On("Logger").Return(log)
+	db, _ := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	sv.On("Database").Return(db)
 	server.UpdateInstance(sv)
 	return sv, buf
 }
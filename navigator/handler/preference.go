@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/core/model"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/navigator/message"
+	"pixels-emulator/navigator/preference"
+	"strconv"
+)
+
+// NavigatorPreferenceHandler stores the saved searches, window and categories preferences of the users.
+type NavigatorPreferenceHandler struct {
+	logger *zap.Logger // logger for packet processing details.
+	db     *gorm.DB    // db is the database to persist the preferences.
+}
+
+// Handle processes the incoming navigator preference packets.
+func (h *NavigatorPreferenceHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	uid, err := strconv.Atoi(conn.Identifier())
+	if err != nil {
+		h.logger.Error("invalid navigator user identifier", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+	user := uint(uid)
+
+	searches := false
+	switch pck := raw.(type) {
+	case *message.NavigatorSaveSearchPacket:
+		err = preference.SaveSearch(ctx, h.db, user, pck.Code, pck.Filter)
+		if errors.Is(err, preference.ErrSearchLimit) {
+			h.logger.Debug("saved searches limit reached", zap.String("identifier", conn.Identifier()))
+			return
+		}
+		searches = true
+	case *message.NavigatorDeleteSearchPacket:
+		err = preference.DeleteSearch(ctx, h.db, user, uint(pck.Search))
+		searches = true
+	case *message.NavigatorSaveSettingsPacket:
+		err = preference.SaveWindow(ctx, h.db, &model.NavigatorPreference{
+			UserID:         user,
+			WindowX:        int(pck.X),
+			WindowY:        int(pck.Y),
+			WindowWidth:    int(pck.Width),
+			WindowHeight:   int(pck.Height),
+			LeftPaneHidden: pck.LeftPaneHidden,
+			ResultsMode:    int(pck.ResultsMode),
+		})
+	case *message.NavigatorCollapseCategoryPacket:
+		err = preference.Collapse(ctx, h.db, user, pck.Code, true)
+	case *message.NavigatorExpandCategoryPacket:
+		err = preference.Collapse(ctx, h.db, user, pck.Code, false)
+	case *message.NavigatorCategoryListModePacket:
+		err = preference.ListMode(ctx, h.db, user, pck.Code, pck.Mode == 1)
+	default:
+		h.logger.Error("cannot cast navigator preference packet, skipping processing")
+		return
+	}
+
+	if err != nil {
+		h.logger.Error("error saving navigator preference", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if !searches {
+		return
+	}
+
+	saved, err := preference.Searches(ctx, h.db, user)
+	if err != nil {
+		h.logger.Error("error loading navigator saved searches", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+	conn.SendPacket(&message.NavigatorSavedSearchesPacket{Searches: preference.Encode(saved)})
+
+}
+
+// NewNavigatorPreference creates a new handler instance.
+func NewNavigatorPreference() *NavigatorPreferenceHandler {
+	return &NavigatorPreferenceHandler{
+		logger: server.GetServer().Logger(),
+		db:     server.GetServer().Database(),
+	}
+}
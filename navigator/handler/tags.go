@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/navigator/message"
+	"pixels-emulator/room"
+)
+
+// MaxPopularTags defines the amount of tags listed by the navigator.
+const MaxPopularTags = 50
+
+// NavigatorPopularTagsHandler lists the tags of the rooms with more players in-game.
+type NavigatorPopularTagsHandler struct {
+	logger    *zap.Logger // logger for packet processing details.
+	roomStore room.Store  // roomStore provides the live rooms.
+}
+
+// Handle processes the incoming popular tags packet.
+func (h *NavigatorPopularTagsHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	if _, ok := raw.(*message.NavigatorGetPopularTagsPacket); !ok {
+		h.logger.Error("cannot cast popular tags packet, skipping processing")
+		return
+	}
+
+	rooms, err := h.roomStore.Records().GetAll(ctx)
+	if err != nil {
+		h.logger.Error("error loading live rooms", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	tags := room.PopularTags(rooms, MaxPopularTags)
+	enc := make([]message.PopularTag, len(tags))
+	for i, t := range tags {
+		enc[i] = message.PopularTag{Tag: t.Tag, Count: int32(t.Count)}
+	}
+
+	conn.SendPacket(&message.NavigatorPopularTagsPacket{Tags: enc})
+
+}
+
+// NewNavigatorPopularTags creates a new handler instance.
+func NewNavigatorPopularTags() *NavigatorPopularTagsHandler {
+	return &NavigatorPopularTagsHandler{
+		logger:    server.GetServer().Logger(),
+		roomStore: server.GetServer().RoomStore(),
+	}
+}
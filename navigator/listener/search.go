@@ -1,16 +1,45 @@
 package listener
 
 import (
+	"context"
 	"errors"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 	"pixels-emulator/core/event"
+	"pixels-emulator/core/model"
 	"pixels-emulator/core/server"
 	"pixels-emulator/navigator/encode"
 	eventNav "pixels-emulator/navigator/event"
+	repository "pixels-emulator/navigator/filter"
 	"pixels-emulator/navigator/message"
+	"pixels-emulator/navigator/preference"
+	"pixels-emulator/room"
 	roomEncode "pixels-emulator/room/encode"
+	"sort"
+	"strconv"
+	"time"
 )
 
+const (
+	PageSize   = 10  // PageSize defines the rooms shown by a category before asking for more.
+	MaxResults = 100 // MaxResults defines the rooms shown by an expanded category or a search.
+)
+
+// DefaultDisplays are the categories of each realm used when none is configured.
+var DefaultDisplays = map[string][]model.NavigatorDisplay{
+	"official_view": {{Filter: repository.Official, DisplayType: "thumbnails", OrderType: "order"}},
+	"hotel_view": {
+		{Filter: repository.Popular, DisplayType: "list", OrderType: "activity"},
+		{Filter: repository.TopRated, DisplayType: "list", OrderType: "order"},
+	},
+	"roomads_view": {{Filter: repository.Promotions, DisplayType: "list", OrderType: "activity"}},
+	"myworld_view": {
+		{Filter: repository.My, DisplayType: "list", OrderType: "order"},
+		{Filter: repository.Rights, DisplayType: "list", OrderType: "order"},
+		{Filter: repository.History, DisplayType: "list", OrderType: "order"},
+	},
+}
+
 func ProvideSearch() func(event event.Event) {
 	return func(event event.Event) {
 		OnNavigatorSearch(event)
@@ -22,52 +51,168 @@ func OnNavigatorSearch(ev event.Event) {
 	var err error
 	defer func() {
 		if err != nil {
-			server.GetServer().Logger().Error("error during authentication handle", zap.Error(err))
+			server.GetServer().Logger().Error("error during navigator search", zap.Error(err))
 		}
 	}()
 
 	queryEv, valid := ev.(*eventNav.NavigatorQueryEvent)
 	if !valid {
-		err = errors.New("event proportioned was not authentication")
+		err = errors.New("event proportioned was not navigator query")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	uid, err := strconv.Atoi(queryEv.Conn().Identifier())
+	if err != nil {
 		return
 	}
 
-	r := []*encode.SearchResultCompound{
-		{
-			Code:       queryEv.Realm(),
-			Query:      queryEv.RawQuery(),
-			Collapsed:  false,
-			Actionable: false,
-			Thumbnails: false,
-			Rooms: []*roomEncode.RoomData{
-				{
-					ID:                1,
-					Name:              "Test Room",
-					OwnerID:           100,
-					OwnerName:         "Owner",
-					IsPublic:          false,
-					DoorMode:          roomEncode.Door(2),
-					UserCount:         10,
-					UserMax:           50,
-					Description:       "A test room",
-					Score:             200,
-					Category:          3,
-					Tags:              []string{"fun", "game"},
-					GuildID:           0,
-					GuildName:         "",
-					GuildBadge:        "",
-					PromotionTitle:    "Special Promo",
-					PromotionDesc:     "Limited time event",
-					PromotionTime:     120,
-					Thumbnail:         "thumbnail.png",
-					AllowPets:         true,
-					FeaturedPromotion: true,
-				},
-			},
-		},
-	}
-
-	cr := message.ComposeNavigatorSearchResult(queryEv.Realm(), queryEv.RawQuery(), r)
+	db := server.GetServer().Database().WithContext(ctx)
+	rs := server.GetServer().RoomStore()
+	s := &repository.Search{User: uint(uid), Query: queryEv.Query()}
+	realm := queryEv.Realm()
+
+	prefs, err := preference.Categories(ctx, db, uint(uid))
+	if err != nil {
+		return
+	}
+
+	var r []*encode.SearchResultCompound
+	switch {
+	case repository.Searching(s.Query):
+		// Text searches look up every room, whatever the realm.
+		s.Offset, s.Limit = repository.Offset(s.Query), MaxResults+1
+		var rooms []model.Room
+		rooms, err = repository.GetRooms(db, s)
+		if err != nil {
+			return
+		}
+		rooms, more := trim(rooms, MaxResults)
+		r = append(r, compound(ctx, rs, realm, queryEv.RawQuery(), model.NavigatorDisplay{DisplayType: "list"}, prefs[realm], rooms, more))
+	case repository.FilterExists(realm):
+		// Expanded category from the more action, paged by the offset of the query.
+		s.Offset, s.Limit = repository.Offset(s.Query), MaxResults+1
+		s.Active = repository.Active(ctx, rs)
+		var rooms []model.Room
+		rooms, err = repository.GetRoomsByFilter(db, realm, s)
+		if err != nil {
+			return
+		}
+		rooms, more := trim(rooms, MaxResults)
+		r = append(r, compound(ctx, rs, realm, queryEv.RawQuery(), model.NavigatorDisplay{DisplayType: "list", OrderType: "activity"}, prefs[realm], rooms, more))
+	default:
+		var displays []model.NavigatorDisplay
+		displays, err = Displays(db, realm)
+		if err != nil {
+			return
+		}
+		var active []uint
+		for _, d := range displays {
+			s.Limit = PageSize + 1
+			s.Active = nil
+			if d.OrderType == "activity" {
+				if active == nil {
+					active = repository.Active(ctx, rs)
+				}
+				s.Active = active
+			}
+			rooms, fErr := repository.GetRoomsByFilter(db, d.Filter, s)
+			if fErr != nil {
+				err = fErr
+				return
+			}
+			rooms, more := trim(rooms, PageSize)
+			r = append(r, compound(ctx, rs, d.Filter, d.Name, d, prefs[d.Filter], rooms, more))
+		}
+	}
+
+	cr := message.ComposeNavigatorSearchResult(realm, queryEv.RawQuery(), r)
 	queryEv.Conn().SendPacket(cr)
 
 }
+
+// Displays provides the configured categories of a realm by priority, or the default ones.
+// The default hotel view lists as well the rooms of each visible room category.
+func Displays(db *gorm.DB, realm string) ([]model.NavigatorDisplay, error) {
+
+	var displays []model.NavigatorDisplay
+	if err := db.Where("realm = ?", realm).Order("priority DESC").Find(&displays).Error; err != nil {
+		return nil, err
+	}
+
+	if len(displays) > 0 {
+		return displays, nil
+	}
+
+	displays = append(displays, DefaultDisplays[realm]...)
+	if realm != "hotel_view" {
+		return displays, nil
+	}
+
+	var cats []model.RoomCategory
+	if err := db.Where("visible = ?", true).Order("id").Find(&cats).Error; err != nil {
+		return nil, err
+	}
+
+	for _, c := range cats {
+		displays = append(displays, model.NavigatorDisplay{Name: c.Caption, Filter: repository.Category(c.ID), DisplayType: "list", OrderType: "activity"})
+	}
+	return displays, nil
+
+}
+
+// compound encodes a navigator category with its rooms and their live players,
+// displayed as preferred by the user.
+func compound(ctx context.Context, rs room.Store, code, query string, d model.NavigatorDisplay, p model.NavigatorCategoryPreference, rooms []model.Room, more bool) *encode.SearchResultCompound {
+
+	loaded := make(map[uint]*room.Room, len(rooms))
+	for _, m := range rooms {
+		if t, err := rs.Records().Read(ctx, strconv.Itoa(int(m.ID))); err == nil {
+			loaded[m.ID] = t
+		}
+	}
+
+	if d.OrderType == "activity" {
+		sort.SliceStable(rooms, func(i, j int) bool {
+			return population(loaded[rooms[i].ID]) > population(loaded[rooms[j].ID])
+		})
+	}
+
+	enc := make([]*roomEncode.RoomData, len(rooms))
+	for i := range rooms {
+		enc[i] = room.EncodeRoom(&rooms[i], loaded[rooms[i].ID])
+	}
+
+	thumbnails := d.DisplayType == "thumbnails"
+	if p.Thumbnails != nil {
+		thumbnails = *p.Thumbnails
+	}
+
+	return &encode.SearchResultCompound{
+		Code:       code,
+		Query:      query,
+		Collapsed:  p.Collapsed,
+		Actionable: more,
+		Thumbnails: thumbnails,
+		Rooms:      enc,
+	}
+
+}
+
+// trim keeps the first rooms of a page, checking if more rooms were found.
+func trim(rooms []model.Room, n int) ([]model.Room, bool) {
+	if len(rooms) > n {
+		return rooms[:n], true
+	}
+	return rooms, false
+}
+
+// population provides the players in-game of a room, zero if not loaded.
+func population(r *room.Room) int {
+	if r == nil {
+		return 0
+	}
+	return r.Population()
+}
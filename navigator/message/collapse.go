@@ -0,0 +1,39 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// NavigatorCollapseCategoryCode is the unique identifier for the packet
+const NavigatorCollapseCategoryCode = 1834
+
+// NavigatorCollapseCategoryPacket represents a packet sent by client when a category is collapsed.
+type NavigatorCollapseCategoryPacket struct {
+	Code string // Code is the filter of the category.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *NavigatorCollapseCategoryPacket) Id() uint16 {
+	return NavigatorCollapseCategoryCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *NavigatorCollapseCategoryPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *NavigatorCollapseCategoryPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeNavigatorCollapseCategoryPacket composes a new instance of the packet.
+func ComposeNavigatorCollapseCategoryPacket(pck protocol.RawPacket) (*NavigatorCollapseCategoryPacket, error) {
+
+	c, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	return &NavigatorCollapseCategoryPacket{Code: c}, nil
+
+}
@@ -0,0 +1,31 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeNavigatorCollapseCategoryPacket verifies the packet is composed from its content.
+func TestComposeNavigatorCollapseCategoryPacket(t *testing.T) {
+	raw := protocol.NewPacket(NavigatorCollapseCategoryCode)
+	raw.AddString("value1")
+
+	pck, err := ComposeNavigatorCollapseCategoryPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, "value1", pck.Code)
+
+	_, err = ComposeNavigatorCollapseCategoryPacket(protocol.NewPacket(NavigatorCollapseCategoryCode))
+	assert.Error(t, err)
+}
+
+// TestNavigatorCollapseCategoryPacket_Integrity tests packet ID, deadline, and rate.
+func TestNavigatorCollapseCategoryPacket_Integrity(t *testing.T) {
+	pck := &NavigatorCollapseCategoryPacket{}
+	assert.Equal(t, uint16(NavigatorCollapseCategoryCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
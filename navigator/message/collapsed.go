@@ -0,0 +1,37 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// NavigatorCollapsedCode is the unique identifier for the packet
+const NavigatorCollapsedCode = 1543
+
+// NavigatorCollapsedPacket provides the categories collapsed by the user.
+type NavigatorCollapsedPacket struct {
+	Codes []string // Codes are the filters of the collapsed categories.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *NavigatorCollapsedPacket) Id() uint16 {
+	return NavigatorCollapsedCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *NavigatorCollapsedPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *NavigatorCollapsedPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *NavigatorCollapsedPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(NavigatorCollapsedCode)
+	pck.AddInt(int32(len(p.Codes)))
+	for _, c := range p.Codes {
+		pck.AddString(c)
+	}
+	return pck
+}
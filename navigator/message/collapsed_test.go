@@ -0,0 +1,35 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestNavigatorCollapsedPacket_Serialize verifies the packet serialization.
+func TestNavigatorCollapsedPacket_Serialize(t *testing.T) {
+	pck := &NavigatorCollapsedPacket{Codes: []string{"popular", "my"}}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	n, _ := raw.ReadInt()
+	assert.Equal(t, int32(2), n)
+
+	for _, c := range pck.Codes {
+		code, err := raw.ReadString()
+		assert.NoError(t, err)
+		assert.Equal(t, c, code)
+	}
+}
+
+// TestNavigatorCollapsedPacket_Integrity tests packet ID, deadline, and rate.
+func TestNavigatorCollapsedPacket_Integrity(t *testing.T) {
+	pck := &NavigatorCollapsedPacket{}
+	assert.Equal(t, uint16(NavigatorCollapsedCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
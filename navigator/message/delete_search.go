@@ -0,0 +1,39 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// NavigatorDeleteSearchCode is the unique identifier for the packet
+const NavigatorDeleteSearchCode = 1954
+
+// NavigatorDeleteSearchPacket represents a packet sent by client to remove a saved search.
+type NavigatorDeleteSearchPacket struct {
+	Search int32 // Search is the identifier of the saved search.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *NavigatorDeleteSearchPacket) Id() uint16 {
+	return NavigatorDeleteSearchCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *NavigatorDeleteSearchPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *NavigatorDeleteSearchPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeNavigatorDeleteSearchPacket composes a new instance of the packet.
+func ComposeNavigatorDeleteSearchPacket(pck protocol.RawPacket) (*NavigatorDeleteSearchPacket, error) {
+
+	s, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &NavigatorDeleteSearchPacket{Search: s}, nil
+
+}
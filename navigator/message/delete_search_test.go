@@ -0,0 +1,31 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeNavigatorDeleteSearchPacket verifies the packet is composed from its content.
+func TestComposeNavigatorDeleteSearchPacket(t *testing.T) {
+	raw := protocol.NewPacket(NavigatorDeleteSearchCode)
+	raw.AddInt(int32(1))
+
+	pck, err := ComposeNavigatorDeleteSearchPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.Search)
+
+	_, err = ComposeNavigatorDeleteSearchPacket(protocol.NewPacket(NavigatorDeleteSearchCode))
+	assert.Error(t, err)
+}
+
+// TestNavigatorDeleteSearchPacket_Integrity tests packet ID, deadline, and rate.
+func TestNavigatorDeleteSearchPacket_Integrity(t *testing.T) {
+	pck := &NavigatorDeleteSearchPacket{}
+	assert.Equal(t, uint16(NavigatorDeleteSearchCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,39 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// NavigatorExpandCategoryCode is the unique identifier for the packet
+const NavigatorExpandCategoryCode = 637
+
+// NavigatorExpandCategoryPacket represents a packet sent by client when a category is expanded.
+type NavigatorExpandCategoryPacket struct {
+	Code string // Code is the filter of the category.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *NavigatorExpandCategoryPacket) Id() uint16 {
+	return NavigatorExpandCategoryCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *NavigatorExpandCategoryPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *NavigatorExpandCategoryPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeNavigatorExpandCategoryPacket composes a new instance of the packet.
+func ComposeNavigatorExpandCategoryPacket(pck protocol.RawPacket) (*NavigatorExpandCategoryPacket, error) {
+
+	c, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	return &NavigatorExpandCategoryPacket{Code: c}, nil
+
+}
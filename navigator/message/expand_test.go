@@ -0,0 +1,31 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeNavigatorExpandCategoryPacket verifies the packet is composed from its content.
+func TestComposeNavigatorExpandCategoryPacket(t *testing.T) {
+	raw := protocol.NewPacket(NavigatorExpandCategoryCode)
+	raw.AddString("value1")
+
+	pck, err := ComposeNavigatorExpandCategoryPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, "value1", pck.Code)
+
+	_, err = ComposeNavigatorExpandCategoryPacket(protocol.NewPacket(NavigatorExpandCategoryCode))
+	assert.Error(t, err)
+}
+
+// TestNavigatorExpandCategoryPacket_Integrity tests packet ID, deadline, and rate.
+func TestNavigatorExpandCategoryPacket_Integrity(t *testing.T) {
+	pck := &NavigatorExpandCategoryPacket{}
+	assert.Equal(t, uint16(NavigatorExpandCategoryCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
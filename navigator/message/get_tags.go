@@ -0,0 +1,31 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// NavigatorGetPopularTagsCode is the unique identifier for the packet
+const NavigatorGetPopularTagsCode = 826
+
+// NavigatorGetPopularTagsPacket represents a packet sent by client to list the tags of the most visited rooms.
+type NavigatorGetPopularTagsPacket struct {
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *NavigatorGetPopularTagsPacket) Id() uint16 {
+	return NavigatorGetPopularTagsCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *NavigatorGetPopularTagsPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *NavigatorGetPopularTagsPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeNavigatorGetPopularTagsPacket composes a new instance of the packet.
+func ComposeNavigatorGetPopularTagsPacket(_ protocol.RawPacket) (*NavigatorGetPopularTagsPacket, error) {
+	return &NavigatorGetPopularTagsPacket{}, nil
+}
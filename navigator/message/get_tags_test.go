@@ -0,0 +1,25 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeNavigatorGetPopularTagsPacket verifies the packet is composed without content.
+func TestComposeNavigatorGetPopularTagsPacket(t *testing.T) {
+	pck, err := ComposeNavigatorGetPopularTagsPacket(protocol.NewPacket(NavigatorGetPopularTagsCode))
+	assert.NoError(t, err)
+	assert.NotNil(t, pck)
+}
+
+// TestNavigatorGetPopularTagsPacket_Integrity tests packet ID, deadline, and rate.
+func TestNavigatorGetPopularTagsPacket_Integrity(t *testing.T) {
+	pck := &NavigatorGetPopularTagsPacket{}
+	assert.Equal(t, uint16(NavigatorGetPopularTagsCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
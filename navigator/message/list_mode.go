@@ -0,0 +1,45 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// NavigatorCategoryListModeCode is the unique identifier for the packet
+const NavigatorCategoryListModeCode = 1202
+
+// NavigatorCategoryListModePacket represents a packet sent by client when a category display is switched between list and thumbnails.
+type NavigatorCategoryListModePacket struct {
+	Code string // Code is the filter of the category.
+	Mode int32  // Mode is 0 for list and 1 for thumbnails.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *NavigatorCategoryListModePacket) Id() uint16 {
+	return NavigatorCategoryListModeCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *NavigatorCategoryListModePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *NavigatorCategoryListModePacket) Deadline() uint {
+	return 500
+}
+
+// ComposeNavigatorCategoryListModePacket composes a new instance of the packet.
+func ComposeNavigatorCategoryListModePacket(pck protocol.RawPacket) (*NavigatorCategoryListModePacket, error) {
+
+	c, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &NavigatorCategoryListModePacket{Code: c, Mode: m}, nil
+
+}
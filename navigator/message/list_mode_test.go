@@ -0,0 +1,33 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeNavigatorCategoryListModePacket verifies the packet is composed from its content.
+func TestComposeNavigatorCategoryListModePacket(t *testing.T) {
+	raw := protocol.NewPacket(NavigatorCategoryListModeCode)
+	raw.AddString("value1")
+	raw.AddInt(int32(2))
+
+	pck, err := ComposeNavigatorCategoryListModePacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, "value1", pck.Code)
+	assert.Equal(t, int32(2), pck.Mode)
+
+	_, err = ComposeNavigatorCategoryListModePacket(protocol.NewPacket(NavigatorCategoryListModeCode))
+	assert.Error(t, err)
+}
+
+// TestNavigatorCategoryListModePacket_Integrity tests packet ID, deadline, and rate.
+func TestNavigatorCategoryListModePacket_Integrity(t *testing.T) {
+	pck := &NavigatorCategoryListModePacket{}
+	assert.Equal(t, uint16(NavigatorCategoryListModeCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -8,14 +8,13 @@ const NavigatorMetaDataCode = 3052
 // NavigatorMetaDataPacket represents a crucial packet for Nitro
 // navigator.
 //
-// It must provide the top level contextual categories.
-//
-// INVESTIGATION: At the moment, I have not seen any non-static implementation, but it can be
-// worked out in the future, for now, it will just return the default 4 base categories.
+// It must provide the top level contextual categories, along
+// the saved searches made on each of them.
 type NavigatorMetaDataPacket struct {
 	protocol.Packet
 
-	Contexts []string // Contexts sets a list of the top view contexts provided.
+	Contexts []string      // Contexts sets a list of the top view contexts provided.
+	Searches []SavedSearch // Searches are the saved searches of the user, grouped by context.
 
 }
 
@@ -41,7 +40,18 @@ func (p *NavigatorMetaDataPacket) Serialize() protocol.RawPacket {
 	pck.AddInt(int32(len(p.Contexts)))
 	for _, navCtx := range p.Contexts {
 		pck.AddString(navCtx)
-		pck.AddInt(0)
+
+		var searches []SavedSearch
+		for _, s := range p.Searches {
+			if s.Code == navCtx {
+				searches = append(searches, s)
+			}
+		}
+
+		pck.AddInt(int32(len(searches)))
+		for _, s := range searches {
+			s.Encode(&pck)
+		}
 	}
 
 	return pck
@@ -44,3 +44,33 @@ func TestNavigatorMetaDataPacketSerialize(t *testing.T) {
 
 	assert.Equal(t, expected, serialized, "NavigatorMetaDataPacket.Serialize() mismatch")
 }
+
+// TestNavigatorMetaDataPacketSerialize_Searches verifies saved searches are encoded under their context.
+func TestNavigatorMetaDataPacketSerialize_Searches(t *testing.T) {
+	packet := NewNavigatorMetaDataPacket("hotel_view", "myworld_view")
+	packet.Searches = []SavedSearch{{Id: 1, Code: "myworld_view", Filter: "tag:fun"}, {Id: 2, Code: "popular"}}
+
+	enc := packet.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	n, _ := raw.ReadInt()
+	assert.Equal(t, int32(2), n)
+
+	code, _ := raw.ReadString()
+	assert.Equal(t, "hotel_view", code)
+	count, _ := raw.ReadInt()
+	assert.Equal(t, int32(0), count)
+
+	code, _ = raw.ReadString()
+	assert.Equal(t, "myworld_view", code)
+	count, _ = raw.ReadInt()
+	assert.Equal(t, int32(1), count)
+
+	id, _ := raw.ReadInt()
+	assert.Equal(t, int32(1), id)
+	code, _ = raw.ReadString()
+	assert.Equal(t, "myworld_view", code)
+	filter, _ := raw.ReadString()
+	assert.Equal(t, "tag:fun", filter)
+}
@@ -0,0 +1,45 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// NavigatorSaveSearchCode is the unique identifier for the packet
+const NavigatorSaveSearchCode = 2226
+
+// NavigatorSaveSearchPacket represents a packet sent by client to store a search in the navigator.
+type NavigatorSaveSearchPacket struct {
+	Code   string // Code is the realm or category of the search.
+	Filter string // Filter is the query of the search.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *NavigatorSaveSearchPacket) Id() uint16 {
+	return NavigatorSaveSearchCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *NavigatorSaveSearchPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *NavigatorSaveSearchPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeNavigatorSaveSearchPacket composes a new instance of the packet.
+func ComposeNavigatorSaveSearchPacket(pck protocol.RawPacket) (*NavigatorSaveSearchPacket, error) {
+
+	c, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	return &NavigatorSaveSearchPacket{Code: c, Filter: f}, nil
+
+}
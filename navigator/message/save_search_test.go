@@ -0,0 +1,33 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeNavigatorSaveSearchPacket verifies the packet is composed from its content.
+func TestComposeNavigatorSaveSearchPacket(t *testing.T) {
+	raw := protocol.NewPacket(NavigatorSaveSearchCode)
+	raw.AddString("value1")
+	raw.AddString("value2")
+
+	pck, err := ComposeNavigatorSaveSearchPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, "value1", pck.Code)
+	assert.Equal(t, "value2", pck.Filter)
+
+	_, err = ComposeNavigatorSaveSearchPacket(protocol.NewPacket(NavigatorSaveSearchCode))
+	assert.Error(t, err)
+}
+
+// TestNavigatorSaveSearchPacket_Integrity tests packet ID, deadline, and rate.
+func TestNavigatorSaveSearchPacket_Integrity(t *testing.T) {
+	pck := &NavigatorSaveSearchPacket{}
+	assert.Equal(t, uint16(NavigatorSaveSearchCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
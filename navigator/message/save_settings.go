@@ -0,0 +1,69 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// NavigatorSaveSettingsCode is the unique identifier for the packet
+const NavigatorSaveSettingsCode = 3159
+
+// NavigatorSaveSettingsPacket represents a packet sent by client to store the navigator window preferences.
+type NavigatorSaveSettingsPacket struct {
+	X              int32 // X is the horizontal position of the window.
+	Y              int32 // Y is the vertical position of the window.
+	Width          int32 // Width is the width of the window.
+	Height         int32 // Height is the height of the window.
+	LeftPaneHidden bool  // LeftPaneHidden determines if the saved searches pane is hidden.
+	ResultsMode    int32 // ResultsMode defines how the search results are displayed by default.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *NavigatorSaveSettingsPacket) Id() uint16 {
+	return NavigatorSaveSettingsCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *NavigatorSaveSettingsPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *NavigatorSaveSettingsPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeNavigatorSaveSettingsPacket composes a new instance of the packet.
+func ComposeNavigatorSaveSettingsPacket(pck protocol.RawPacket) (*NavigatorSaveSettingsPacket, error) {
+
+	x, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := pck.ReadBoolean()
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &NavigatorSaveSettingsPacket{X: x, Y: y, Width: w, Height: h, LeftPaneHidden: l, ResultsMode: r}, nil
+
+}
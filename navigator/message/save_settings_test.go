@@ -0,0 +1,41 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeNavigatorSaveSettingsPacket verifies the packet is composed from its content.
+func TestComposeNavigatorSaveSettingsPacket(t *testing.T) {
+	raw := protocol.NewPacket(NavigatorSaveSettingsCode)
+	raw.AddInt(int32(1))
+	raw.AddInt(int32(2))
+	raw.AddInt(int32(3))
+	raw.AddInt(int32(4))
+	raw.AddBoolean(true)
+	raw.AddInt(int32(6))
+
+	pck, err := ComposeNavigatorSaveSettingsPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.X)
+	assert.Equal(t, int32(2), pck.Y)
+	assert.Equal(t, int32(3), pck.Width)
+	assert.Equal(t, int32(4), pck.Height)
+	assert.Equal(t, true, pck.LeftPaneHidden)
+	assert.Equal(t, int32(6), pck.ResultsMode)
+
+	_, err = ComposeNavigatorSaveSettingsPacket(protocol.NewPacket(NavigatorSaveSettingsCode))
+	assert.Error(t, err)
+}
+
+// TestNavigatorSaveSettingsPacket_Integrity tests packet ID, deadline, and rate.
+func TestNavigatorSaveSettingsPacket_Integrity(t *testing.T) {
+	pck := &NavigatorSaveSettingsPacket{}
+	assert.Equal(t, uint16(NavigatorSaveSettingsCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,53 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// NavigatorSavedSearchesCode is the unique identifier for the packet
+const NavigatorSavedSearchesCode = 3984
+
+// SavedSearch defines a search stored by the user in the navigator.
+type SavedSearch struct {
+	Id           int32  // Id is the identifier of the saved search.
+	Code         string // Code is the realm or category of the search.
+	Filter       string // Filter is the query of the search.
+	Localization string // Localization is the display name of the search, empty to use the filter.
+}
+
+// Encode writes the saved search into the packet.
+func (s *SavedSearch) Encode(pck *protocol.RawPacket) {
+	pck.AddInt(s.Id)
+	pck.AddString(s.Code)
+	pck.AddString(s.Filter)
+	pck.AddString(s.Localization)
+}
+
+// NavigatorSavedSearchesPacket provides the saved searches of the user.
+type NavigatorSavedSearchesPacket struct {
+	Searches []SavedSearch // Searches are the saved searches of the user.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *NavigatorSavedSearchesPacket) Id() uint16 {
+	return NavigatorSavedSearchesCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *NavigatorSavedSearchesPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *NavigatorSavedSearchesPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *NavigatorSavedSearchesPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(NavigatorSavedSearchesCode)
+	pck.AddInt(int32(len(p.Searches)))
+	for _, s := range p.Searches {
+		s.Encode(&pck)
+	}
+	return pck
+}
@@ -0,0 +1,38 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestNavigatorSavedSearchesPacket_Serialize verifies the packet serialization.
+func TestNavigatorSavedSearchesPacket_Serialize(t *testing.T) {
+	pck := &NavigatorSavedSearchesPacket{Searches: []SavedSearch{{Id: 1, Code: "hotel_view", Filter: "owner:john"}, {Id: 2, Code: "popular"}}}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	n, _ := raw.ReadInt()
+	assert.Equal(t, int32(2), n)
+
+	for _, s := range pck.Searches {
+		id, _ := raw.ReadInt()
+		code, _ := raw.ReadString()
+		filter, _ := raw.ReadString()
+		loc, err := raw.ReadString()
+		assert.NoError(t, err)
+		assert.Equal(t, s, SavedSearch{Id: id, Code: code, Filter: filter, Localization: loc})
+	}
+}
+
+// TestNavigatorSavedSearchesPacket_Integrity tests packet ID, deadline, and rate.
+func TestNavigatorSavedSearchesPacket_Integrity(t *testing.T) {
+	pck := &NavigatorSavedSearchesPacket{}
+	assert.Equal(t, uint16(NavigatorSavedSearchesCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,44 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// NavigatorSettingsCode is the unique identifier for the packet
+const NavigatorSettingsCode = 518
+
+// NavigatorSettingsPacket provides the navigator window preferences of the user.
+type NavigatorSettingsPacket struct {
+	X              int32 // X is the horizontal position of the window.
+	Y              int32 // Y is the vertical position of the window.
+	Width          int32 // Width is the width of the window.
+	Height         int32 // Height is the height of the window.
+	LeftPaneHidden bool  // LeftPaneHidden determines if the saved searches pane is hidden.
+	ResultsMode    int32 // ResultsMode defines how the search results are displayed by default.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *NavigatorSettingsPacket) Id() uint16 {
+	return NavigatorSettingsCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *NavigatorSettingsPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *NavigatorSettingsPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *NavigatorSettingsPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(NavigatorSettingsCode)
+	pck.AddInt(p.X)
+	pck.AddInt(p.Y)
+	pck.AddInt(p.Width)
+	pck.AddInt(p.Height)
+	pck.AddBoolean(p.LeftPaneHidden)
+	pck.AddInt(p.ResultsMode)
+	return pck
+}
@@ -0,0 +1,51 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestNavigatorSettingsPacket_Serialize verifies the packet serialization.
+func TestNavigatorSettingsPacket_Serialize(t *testing.T) {
+	pck := &NavigatorSettingsPacket{X: int32(1), Y: int32(2), Width: int32(3), Height: int32(4), LeftPaneHidden: true, ResultsMode: int32(6)}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(NavigatorSettingsCode), raw.GetHeader())
+
+	x0, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), x0)
+
+	y1, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), y1)
+
+	w2, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), w2)
+
+	h3, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), h3)
+
+	l4, err := raw.ReadBoolean()
+	assert.NoError(t, err)
+	assert.Equal(t, true, l4)
+
+	r5, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(6), r5)
+}
+
+// TestNavigatorSettingsPacket_Integrity tests packet ID, deadline, and rate.
+func TestNavigatorSettingsPacket_Integrity(t *testing.T) {
+	pck := &NavigatorSettingsPacket{}
+	assert.Equal(t, uint16(NavigatorSettingsCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
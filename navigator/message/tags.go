@@ -0,0 +1,44 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// NavigatorPopularTagsCode is the unique identifier for the packet
+const NavigatorPopularTagsCode = 2012
+
+// PopularTag defines a room tag along the players using it.
+type PopularTag struct {
+	Tag   string // Tag is the normalized room tag.
+	Count int32  // Count is the popularity of the tag.
+}
+
+// NavigatorPopularTagsPacket provides the tags of the most visited rooms.
+type NavigatorPopularTagsPacket struct {
+	Tags []PopularTag // Tags are the tags ordered by popularity.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *NavigatorPopularTagsPacket) Id() uint16 {
+	return NavigatorPopularTagsCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *NavigatorPopularTagsPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *NavigatorPopularTagsPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *NavigatorPopularTagsPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(NavigatorPopularTagsCode)
+	pck.AddInt(int32(len(p.Tags)))
+	for _, t := range p.Tags {
+		pck.AddString(t.Tag)
+		pck.AddInt(t.Count)
+	}
+	return pck
+}
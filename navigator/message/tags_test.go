@@ -0,0 +1,39 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestNavigatorPopularTagsPacket_Serialize verifies the packet serialization.
+func TestNavigatorPopularTagsPacket_Serialize(t *testing.T) {
+	pck := &NavigatorPopularTagsPacket{Tags: []PopularTag{{Tag: "party", Count: 12}, {Tag: "games", Count: 3}}}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	n, _ := raw.ReadInt()
+	assert.Equal(t, int32(2), n)
+
+	for _, tag := range pck.Tags {
+		name, err := raw.ReadString()
+		assert.NoError(t, err)
+		assert.Equal(t, tag.Tag, name)
+
+		count, err := raw.ReadInt()
+		assert.NoError(t, err)
+		assert.Equal(t, tag.Count, count)
+	}
+}
+
+// TestNavigatorPopularTagsPacket_Integrity tests packet ID, deadline, and rate.
+func TestNavigatorPopularTagsPacket_Integrity(t *testing.T) {
+	pck := &NavigatorPopularTagsPacket{}
+	assert.Equal(t, uint16(NavigatorPopularTagsCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,122 @@
+package preference
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"pixels-emulator/core/model"
+	"pixels-emulator/navigator/message"
+)
+
+// MaxSavedSearches defines the amount of searches a user can save.
+const MaxSavedSearches = 50
+
+const (
+	DefaultWindowX      = 100 // DefaultWindowX defines the horizontal position of a window never saved.
+	DefaultWindowY      = 100 // DefaultWindowY defines the vertical position of a window never saved.
+	DefaultWindowWidth  = 425 // DefaultWindowWidth defines the width of a window never saved.
+	DefaultWindowHeight = 535 // DefaultWindowHeight defines the height of a window never saved.
+)
+
+// ErrSearchLimit rejects saving searches over MaxSavedSearches.
+var ErrSearchLimit = errors.New("saved searches limit reached")
+
+// SaveSearch stores a search of the user, unless the limit is reached. The user is
+// locked while the searches are counted, so concurrent saves can not exceed the limit.
+func SaveSearch(ctx context.Context, db *gorm.DB, user uint, code, filter string) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+
+		var locked []uint
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Model(&model.User{}).Where("id = ?", user).Pluck("id", &locked).Error; err != nil {
+			return err
+		}
+
+		var n int64
+		if err := tx.Model(&model.NavigatorSavedSearch{}).Where("user_id = ?", user).Count(&n).Error; err != nil {
+			return err
+		}
+
+		if n >= MaxSavedSearches {
+			return ErrSearchLimit
+		}
+
+		return tx.Create(&model.NavigatorSavedSearch{UserID: user, Code: code, Filter: filter}).Error
+
+	})
+}
+
+// DeleteSearch removes a saved search of the user.
+func DeleteSearch(ctx context.Context, db *gorm.DB, user, id uint) error {
+	return db.WithContext(ctx).Unscoped().Where("id = ? AND user_id = ?", id, user).Delete(&model.NavigatorSavedSearch{}).Error
+}
+
+// Searches provides the saved searches of the user.
+func Searches(ctx context.Context, db *gorm.DB, user uint) ([]model.NavigatorSavedSearch, error) {
+	var s []model.NavigatorSavedSearch
+	err := db.WithContext(ctx).Where("user_id = ?", user).Order("id").Find(&s).Error
+	return s, err
+}
+
+// Window provides the navigator window preferences of the user, with the defaults if never saved.
+func Window(ctx context.Context, db *gorm.DB, user uint) (*model.NavigatorPreference, error) {
+	p := &model.NavigatorPreference{}
+	err := db.WithContext(ctx).Where("user_id = ?", user).
+		Attrs(model.NavigatorPreference{UserID: user, WindowX: DefaultWindowX, WindowY: DefaultWindowY, WindowWidth: DefaultWindowWidth, WindowHeight: DefaultWindowHeight}).
+		FirstOrInit(p).Error
+	return p, err
+}
+
+// windowColumns are the navigator window preferences written, zero values included.
+var windowColumns = []string{"window_x", "window_y", "window_width", "window_height", "left_pane_hidden", "results_mode", "updated_at"}
+
+// SaveWindow stores the navigator window preferences of the user.
+func SaveWindow(ctx context.Context, db *gorm.DB, p *model.NavigatorPreference) error {
+	return db.WithContext(ctx).Select(append([]string{"user_id", "created_at"}, windowColumns...)).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns(windowColumns),
+	}).Create(p).Error
+}
+
+// Categories provides the display preferences of the user by category code.
+func Categories(ctx context.Context, db *gorm.DB, user uint) (map[string]model.NavigatorCategoryPreference, error) {
+
+	var prefs []model.NavigatorCategoryPreference
+	if err := db.WithContext(ctx).Where("user_id = ?", user).Find(&prefs).Error; err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]model.NavigatorCategoryPreference, len(prefs))
+	for _, p := range prefs {
+		res[p.Code] = p
+	}
+	return res, nil
+
+}
+
+// Collapse stores if a category is collapsed for the user.
+func Collapse(ctx context.Context, db *gorm.DB, user uint, code string, collapsed bool) error {
+	return category(ctx, db, &model.NavigatorCategoryPreference{UserID: user, Code: code, Collapsed: collapsed}, "collapsed")
+}
+
+// ListMode stores if a category is displayed as thumbnails for the user.
+func ListMode(ctx context.Context, db *gorm.DB, user uint, code string, thumbnails bool) error {
+	return category(ctx, db, &model.NavigatorCategoryPreference{UserID: user, Code: code, Thumbnails: &thumbnails}, "thumbnails")
+}
+
+// category creates the category preference or updates only the column provided.
+func category(ctx context.Context, db *gorm.DB, p *model.NavigatorCategoryPreference, column string) error {
+	return db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "code"}},
+		DoUpdates: clause.AssignmentColumns([]string{column, "updated_at"}),
+	}).Create(p).Error
+}
+
+// Encode provides the protocol version of the saved searches.
+func Encode(searches []model.NavigatorSavedSearch) []message.SavedSearch {
+	enc := make([]message.SavedSearch, len(searches))
+	for i, s := range searches {
+		enc[i] = message.SavedSearch{Id: int32(s.ID), Code: s.Code, Filter: s.Filter}
+	}
+	return enc
+}
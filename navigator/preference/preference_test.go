@@ -0,0 +1,51 @@
+package preference
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"pixels-emulator/core/database"
+	"pixels-emulator/core/model"
+	"pixels-emulator/navigator/message"
+)
+
+// dryRun creates a database session which only builds the statements.
+func dryRun(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+	assert.NoError(t, err)
+	return db
+}
+
+// TestEncode verifies the saved searches are encoded with their identifiers.
+func TestEncode(t *testing.T) {
+	s := []model.NavigatorSavedSearch{{BaseModel: database.BaseModel{ID: 3}, Code: "hotel_view", Filter: "tag:fun"}}
+	assert.Equal(t, []message.SavedSearch{{Id: 3, Code: "hotel_view", Filter: "tag:fun"}}, Encode(s))
+}
+
+// TestWindow verifies the default window is provided for users without preferences.
+func TestWindow(t *testing.T) {
+	w, err := Window(context.Background(), dryRun(t), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), w.UserID)
+	assert.Equal(t, 425, w.WindowWidth)
+	assert.Equal(t, 535, w.WindowHeight)
+}
+
+// TestSaveWindow verifies the zero positions are written instead of replaced by a default.
+func TestSaveWindow(t *testing.T) {
+	db := dryRun(t)
+
+	var sql string
+	var vars []interface{}
+	assert.NoError(t, db.Callback().Create().After("gorm:create").Register("test:capture", func(tx *gorm.DB) {
+		sql, vars = tx.Statement.SQL.String(), tx.Statement.Vars
+	}))
+
+	assert.NoError(t, SaveWindow(context.Background(), db, &model.NavigatorPreference{UserID: 1, WindowWidth: 425, WindowHeight: 535}))
+	assert.Contains(t, sql, "`window_x`,`window_y`")
+	assert.Contains(t, sql, "`window_x`=VALUES(`window_x`)")
+	assert.Contains(t, vars, 0, "zero positions are bound")
+}
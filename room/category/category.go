@@ -0,0 +1,51 @@
+package category
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"pixels-emulator/core/model"
+	"pixels-emulator/role"
+	msg "pixels-emulator/room/message/category"
+)
+
+// All provides the room categories.
+func All(ctx context.Context, db *gorm.DB) ([]model.RoomCategory, error) {
+	var cats []model.RoomCategory
+	err := db.WithContext(ctx).Order("id").Find(&cats).Error
+	return cats, err
+}
+
+// Allowed checks if a user has the permission required to assign rooms to the category.
+func Allowed(c model.RoomCategory, u model.User) bool {
+	return c.Permission == "" || role.HasPermission(u, c.Permission)
+}
+
+// Assignable checks if a user can assign a room to a category. Rooms without category are always allowed.
+func Assignable(ctx context.Context, db *gorm.DB, id uint, u model.User) (bool, error) {
+
+	if id == 0 {
+		return true, nil
+	}
+
+	var cats []model.RoomCategory
+	if err := db.WithContext(ctx).Where("id = ?", id).Limit(1).Find(&cats).Error; err != nil {
+		return false, err
+	}
+
+	return len(cats) > 0 && cats[0].Visible && Allowed(cats[0], u), nil
+
+}
+
+// Encode provides the protocol version of the categories, only selectable by the user if allowed.
+func Encode(cats []model.RoomCategory, u model.User) []msg.FlatCategory {
+	enc := make([]msg.FlatCategory, len(cats))
+	for i, c := range cats {
+		enc[i] = msg.FlatCategory{
+			Id:        int32(c.ID),
+			Caption:   c.Caption,
+			Visible:   c.Visible && Allowed(c, u),
+			StaffOnly: c.Permission != "",
+		}
+	}
+	return enc
+}
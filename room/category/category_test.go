@@ -0,0 +1,47 @@
+package category
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"pixels-emulator/core/database"
+	"pixels-emulator/core/model"
+	msg "pixels-emulator/room/message/category"
+)
+
+// TestAllowed verifies categories without permission are allowed to everyone.
+func TestAllowed(t *testing.T) {
+	u := model.User{Roles: []model.Role{{Priority: 1, Permissions: []model.RolePermission{{Permission: "pixels.category.staff"}}}}}
+	assert.True(t, Allowed(model.RoomCategory{}, model.User{}))
+	assert.False(t, Allowed(model.RoomCategory{Permission: "pixels.category.staff"}, model.User{}))
+	assert.True(t, Allowed(model.RoomCategory{Permission: "pixels.category.staff"}, u))
+}
+
+// TestAssignable_None verifies rooms can always be left without category.
+func TestAssignable_None(t *testing.T) {
+	db, err := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	assert.NoError(t, err)
+
+	ok, err := Assignable(context.Background(), db, 0, model.User{})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = Assignable(context.Background(), db, 5, model.User{})
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+// TestEncode verifies the categories are only selectable when allowed.
+func TestEncode(t *testing.T) {
+	cats := []model.RoomCategory{
+		{BaseModel: database.BaseModel{ID: 1}, Caption: "Games", Visible: true},
+		{BaseModel: database.BaseModel{ID: 2}, Caption: "Staff", Visible: true, Permission: "pixels.category.staff"},
+	}
+	assert.Equal(t, []msg.FlatCategory{
+		{Id: 1, Caption: "Games", Visible: true},
+		{Id: 2, Caption: "Staff", Visible: false, StaffOnly: true},
+	}, Encode(cats, model.User{}))
+}
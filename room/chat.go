@@ -0,0 +1,101 @@
+package room
+
+import (
+	"pixels-emulator/room/encode"
+	"pixels-emulator/room/message/chat"
+	"pixels-emulator/user"
+	"strings"
+	"time"
+)
+
+const (
+	FloodWindow = 4 * time.Second  // FloodWindow defines the time window where chat messages are counted.
+	FloodMute   = 30 * time.Second // FloodMute defines the time a flooding player is muted.
+)
+
+// FloodLimit provides the amount of messages allowed inside the flood window for a protection level.
+func FloodLimit(protection encode.ChatFilter) int {
+	switch protection {
+	case encode.FloodFilterStrict:
+		return 3
+	case encode.FloodFilterLoose:
+		return 8
+	default:
+		return 5
+	}
+}
+
+// Gesture provides the avatar expression of a message based on its emoticons.
+func Gesture(msg string) int32 {
+	switch {
+	case strings.Contains(msg, ":@") || strings.Contains(msg, ">:("):
+		return 2
+	case strings.Contains(msg, ":o") || strings.Contains(msg, ":O"):
+		return 3
+	case strings.Contains(msg, ":("):
+		return 4
+	case strings.Contains(msg, ":)") || strings.Contains(msg, ":D") || strings.Contains(msg, ";)"):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Hears checks if a player is within the hearing distance of the speaker.
+// A non-positive distance means the whole room can hear.
+func (r *Room) Hears(speaker, listener *user.Player) bool {
+
+	r.dataMu.RLock()
+	d := r.data.Configuration.ChatHearingDistance
+	r.dataMu.RUnlock()
+	if d <= 0 || speaker == listener {
+		return true
+	}
+
+	s, l := speaker.Unit().Current, listener.Unit().Current
+	dx, dy := int(s.X())-int(l.X()), int(s.Y())-int(l.Y())
+	return dx*dx+dy*dy <= d*d
+
+}
+
+// PlayerByName provides the in-game player with the given username.
+func (r *Room) PlayerByName(username string) *user.Player {
+	for _, p := range r.Online() {
+		if strings.EqualFold(p.Username, username) {
+			return p
+		}
+	}
+	return nil
+}
+
+// Chat delivers a message said by a player according to the chat type.
+// Whispers are only delivered to the speaker and the target.
+func (r *Room) Chat(p *user.Player, t chat.Type, msg string, bubble int32, target *user.Player) {
+
+	pck := &chat.UnitChatPacket{
+		Type:    t,
+		Unit:    p.Unit().Id,
+		Message: msg,
+		Gesture: Gesture(msg),
+		Bubble:  bubble,
+	}
+
+	switch t {
+	case chat.Whisper:
+		p.Conn().SendPacket(pck)
+		if target != nil && target != p {
+			target.Conn().SendPacket(pck)
+		}
+	case chat.Shout:
+		r.Broadcast(pck)
+	default:
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		for _, l := range r.Players {
+			if r.Hears(p, l) {
+				l.Conn().SendPacket(pck)
+			}
+		}
+	}
+
+}
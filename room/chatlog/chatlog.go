@@ -0,0 +1,23 @@
+package chatlog
+
+import (
+	"pixels-emulator/core/database"
+	"pixels-emulator/core/model"
+	"pixels-emulator/core/server"
+	"sync"
+)
+
+var (
+	once   sync.Once
+	writer *Writer
+)
+
+// GetWriter provides the only chat log writer instance.
+func GetWriter() *Writer {
+	once.Do(func() {
+		sv := server.GetServer()
+		svc := &database.ModelService[model.ChatLog]{DB: sv.Database()}
+		writer = NewWriter(Persist(svc), int(sv.Config().Chat.LogBatch), sv.Logger())
+	})
+	return writer
+}
@@ -0,0 +1,72 @@
+package chatlog
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"pixels-emulator/core/database"
+	"pixels-emulator/core/model"
+	"time"
+)
+
+// Filter defines the criteria to search chat logs. Zero values are not filtered.
+type Filter struct {
+	RoomID   uint      // RoomID filters the logs said in a room.
+	UserID   uint      // UserID filters the logs said by a user.
+	From, To time.Time // From, To filters the logs said inside the time range.
+	Limit    int       // Limit defines the maximum amount of logs provided.
+	Offset   int       // Offset defines the amount of logs skipped.
+}
+
+// apply adds the filter conditions to a query, newest logs first.
+func (f Filter) apply(db *gorm.DB) *gorm.DB {
+
+	if f.RoomID != 0 {
+		db = db.Where("room_id = ?", f.RoomID)
+	}
+
+	if f.UserID != 0 {
+		db = db.Where("user_id = ?", f.UserID)
+	}
+
+	if !f.From.IsZero() {
+		db = db.Where("created_at >= ?", f.From)
+	}
+
+	if !f.To.IsZero() {
+		db = db.Where("created_at <= ?", f.To)
+	}
+
+	if f.Limit > 0 {
+		db = db.Limit(f.Limit)
+	}
+
+	if f.Offset > 0 {
+		db = db.Offset(f.Offset)
+	}
+
+	return db.Order("created_at DESC")
+
+}
+
+// Find provides the chat logs matching the filter.
+func Find(ctx context.Context, svc *database.ModelService[model.ChatLog], f Filter) ([]model.ChatLog, error) {
+	var logs []model.ChatLog
+	err := f.apply(svc.DB.WithContext(ctx)).Find(&logs).Error
+	return logs, err
+}
+
+// ByRoom provides the chat logs said in a room inside the time range.
+func ByRoom(ctx context.Context, svc *database.ModelService[model.ChatLog], room uint, from, to time.Time, limit int) ([]model.ChatLog, error) {
+	return Find(ctx, svc, Filter{RoomID: room, From: from, To: to, Limit: limit})
+}
+
+// ByUser provides the chat logs said by a user inside the time range.
+func ByUser(ctx context.Context, svc *database.ModelService[model.ChatLog], user uint, from, to time.Time, limit int) ([]model.ChatLog, error) {
+	return Find(ctx, svc, Filter{UserID: user, From: from, To: to, Limit: limit})
+}
+
+// Purge permanently removes the chat logs older than the retention.
+func Purge(ctx context.Context, svc *database.ModelService[model.ChatLog], retention time.Duration) (int64, error) {
+	res := svc.DB.WithContext(ctx).Unscoped().Where("created_at < ?", time.Now().Add(-retention)).Delete(&model.ChatLog{})
+	return res.RowsAffected, res.Error
+}
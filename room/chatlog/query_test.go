@@ -0,0 +1,48 @@
+package chatlog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"pixels-emulator/core/model"
+)
+
+// dryRun creates a database session which only builds the statements.
+func dryRun(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	assert.NoError(t, err)
+	return db
+}
+
+// TestFilter_Apply verifies the filter conditions are added to the query.
+func TestFilter_Apply(t *testing.T) {
+	db := dryRun(t)
+	from := time.Now().Add(-time.Hour)
+
+	var logs []model.ChatLog
+	stmt := Filter{RoomID: 1, UserID: 2, From: from, To: time.Now(), Limit: 10, Offset: 5}.apply(db).Find(&logs).Statement
+
+	sql := stmt.SQL.String()
+	assert.Contains(t, sql, "room_id = ?")
+	assert.Contains(t, sql, "user_id = ?")
+	assert.Contains(t, sql, "created_at >= ?")
+	assert.Contains(t, sql, "created_at <= ?")
+	assert.Contains(t, sql, "ORDER BY created_at DESC")
+	assert.Contains(t, sql, "LIMIT ?")
+	assert.Contains(t, sql, "OFFSET ?")
+}
+
+// TestFilter_ApplyEmpty verifies zero values are not filtered.
+func TestFilter_ApplyEmpty(t *testing.T) {
+	db := dryRun(t)
+
+	var logs []model.ChatLog
+	sql := Filter{}.apply(db).Find(&logs).Statement.SQL.String()
+
+	assert.NotContains(t, sql, "room_id")
+	assert.NotContains(t, sql, "user_id")
+	assert.NotContains(t, sql, "LIMIT")
+}
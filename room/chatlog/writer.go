@@ -0,0 +1,128 @@
+package chatlog
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"pixels-emulator/core/database"
+	"pixels-emulator/core/model"
+	"sync"
+	"time"
+)
+
+// PendingBatches defines how many batches are kept pending while the writes fail.
+const PendingBatches = 10
+
+// PersistFunc writes a batch of chat logs.
+type PersistFunc func(ctx context.Context, logs []model.ChatLog) error
+
+// Writer buffers the chat logs and writes them in batches,
+// either when the batch is full or when flushed periodically.
+// Failed batches are requeued, up to PendingBatches batches.
+type Writer struct {
+	mu      sync.Mutex      // mu guards the buffer.
+	buf     []model.ChatLog // buf contains the logs pending to be written.
+	size    int             // size defines the amount of logs written at once.
+	persist PersistFunc     // persist writes the batches.
+	writing sync.WaitGroup  // writing tracks the batches written asynchronously.
+	logger  *zap.Logger     // logger to log failed writes.
+}
+
+// Record buffers a chat log, writing the batch asynchronously when full.
+func (w *Writer) Record(l model.ChatLog) {
+
+	w.mu.Lock()
+	w.buf = append(w.buf, l)
+	if len(w.buf) < w.size {
+		w.mu.Unlock()
+		return
+	}
+	batch := w.take()
+	w.writing.Add(1)
+	w.mu.Unlock()
+
+	go func() {
+		defer w.writing.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := w.write(ctx, batch); err != nil {
+			w.requeue(batch)
+		}
+	}()
+
+}
+
+// Pending provides the amount of logs pending to be written.
+func (w *Writer) Pending() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.buf)
+}
+
+// Flush writes every pending log, once the batches being written finish.
+func (w *Writer) Flush(ctx context.Context) error {
+
+	w.writing.Wait()
+
+	w.mu.Lock()
+	batch := w.take()
+	w.mu.Unlock()
+
+	if len(batch) == 0 {
+		return nil
+	}
+
+	if err := w.write(ctx, batch); err != nil {
+		w.requeue(batch)
+		return err
+	}
+	return nil
+
+}
+
+// take empties the buffer providing its logs. Caller must hold the lock.
+func (w *Writer) take() []model.ChatLog {
+	batch := w.buf
+	w.buf = make([]model.ChatLog, 0, w.size)
+	return batch
+}
+
+// requeue places a failed batch back in front of the buffer, dropping the
+// oldest logs over the pending limit.
+func (w *Writer) requeue(batch []model.ChatLog) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.buf = append(batch, w.buf...)
+	if over := len(w.buf) - w.size*PendingBatches; over > 0 {
+		w.logger.Error("Dropping pending chat logs", zap.Int("amount", over))
+		w.buf = w.buf[over:]
+	}
+}
+
+// write persists a batch logging if failed.
+func (w *Writer) write(ctx context.Context, batch []model.ChatLog) error {
+	err := w.persist(ctx, batch)
+	if err != nil {
+		w.logger.Error("Error writing chat logs", zap.Int("amount", len(batch)), zap.Error(err))
+	}
+	return err
+}
+
+// Persist provides the default batch writing through the model service.
+func Persist(svc *database.ModelService[model.ChatLog]) PersistFunc {
+	return func(ctx context.Context, logs []model.ChatLog) error {
+		return svc.DB.WithContext(ctx).CreateInBatches(logs, len(logs)).Error
+	}
+}
+
+// NewWriter creates a writer with the given batch size.
+func NewWriter(persist PersistFunc, size int, logger *zap.Logger) *Writer {
+	if size <= 0 {
+		size = 1
+	}
+	return &Writer{
+		buf:     make([]model.ChatLog, 0, size),
+		size:    size,
+		persist: persist,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,132 @@
+package chatlog
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/model"
+	"pixels-emulator/core/util"
+)
+
+// recorder stores the batches written.
+type recorder struct {
+	mu      sync.Mutex
+	batches [][]model.ChatLog
+	err     error
+}
+
+func (r *recorder) persist(_ context.Context, logs []model.ChatLog) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batches = append(r.batches, logs)
+	return r.err
+}
+
+func (r *recorder) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.batches)
+}
+
+// TestWriter_Batch verifies logs are written once the batch is full.
+func TestWriter_Batch(t *testing.T) {
+	logger, _ := util.CreateTestLogger()
+	rec := &recorder{}
+	w := NewWriter(rec.persist, 3, logger)
+
+	w.Record(model.ChatLog{Message: "a"})
+	w.Record(model.ChatLog{Message: "b"})
+	assert.Equal(t, 2, w.Pending())
+	assert.Equal(t, 0, rec.count())
+
+	w.Record(model.ChatLog{Message: "c"})
+	assert.Equal(t, 0, w.Pending())
+	assert.Eventually(t, func() bool { return rec.count() == 1 }, time.Second, 5*time.Millisecond)
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+	assert.Len(t, rec.batches[0], 3)
+}
+
+// TestWriter_Flush verifies pending logs are written when flushed.
+func TestWriter_Flush(t *testing.T) {
+	logger, _ := util.CreateTestLogger()
+	rec := &recorder{}
+	w := NewWriter(rec.persist, 10, logger)
+
+	assert.NoError(t, w.Flush(context.Background()))
+	assert.Equal(t, 0, rec.count())
+
+	w.Record(model.ChatLog{Message: "a"})
+	assert.NoError(t, w.Flush(context.Background()))
+	assert.Equal(t, 1, rec.count())
+	assert.Equal(t, 0, w.Pending())
+}
+
+// TestWriter_FlushError verifies write errors are provided, keeping the logs pending.
+func TestWriter_FlushError(t *testing.T) {
+	logger, buf := util.CreateTestLogger()
+	rec := &recorder{err: errors.New("database down")}
+	w := NewWriter(rec.persist, 10, logger)
+
+	w.Record(model.ChatLog{Message: "a"})
+	assert.Error(t, w.Flush(context.Background()))
+	assert.Contains(t, buf.String(), "Error writing chat logs")
+	assert.Equal(t, 1, w.Pending())
+}
+
+// TestWriter_Requeue verifies failed batches are written again, up to the pending limit.
+func TestWriter_Requeue(t *testing.T) {
+	logger, buf := util.CreateTestLogger()
+	rec := &recorder{err: errors.New("database down")}
+	w := NewWriter(rec.persist, 2, logger)
+
+	for i := 0; i < 2*PendingBatches+1; i++ {
+		w.Record(model.ChatLog{Message: "a"})
+		assert.Error(t, w.Flush(context.Background()))
+	}
+	assert.Equal(t, 2*PendingBatches, w.Pending())
+	assert.Contains(t, buf.String(), "Dropping pending chat logs")
+
+	rec.mu.Lock()
+	rec.err = nil
+	rec.mu.Unlock()
+
+	assert.NoError(t, w.Flush(context.Background()))
+	assert.Equal(t, 0, w.Pending())
+}
+
+// TestWriter_FlushWait verifies flushing waits for the batches being written.
+func TestWriter_FlushWait(t *testing.T) {
+	logger, _ := util.CreateTestLogger()
+	release := make(chan struct{})
+	written := 0
+	w := NewWriter(func(_ context.Context, logs []model.ChatLog) error {
+		<-release
+		written += len(logs)
+		return nil
+	}, 1, logger)
+
+	w.Record(model.ChatLog{Message: "a"})
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+	}()
+
+	assert.NoError(t, w.Flush(context.Background()))
+	assert.Equal(t, 1, written)
+}
+
+// TestNewWriter_Size verifies invalid batch sizes write every log.
+func TestNewWriter_Size(t *testing.T) {
+	logger, _ := util.CreateTestLogger()
+	rec := &recorder{}
+	w := NewWriter(rec.persist, 0, logger)
+
+	w.Record(model.ChatLog{Message: "a"})
+	assert.Eventually(t, func() bool { return rec.count() == 1 }, time.Second, 5*time.Millisecond)
+}
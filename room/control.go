@@ -0,0 +1,120 @@
+package room
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/room/encode"
+	"pixels-emulator/room/message/rights"
+	msg "pixels-emulator/room/message/unit"
+	"pixels-emulator/room/unit"
+	"pixels-emulator/user"
+	"strconv"
+)
+
+// ControlLevel defines the flat control level of a player, rendered by Nitro.
+type ControlLevel int32
+
+const (
+	NoControl     ControlLevel = 0 // NoControl defines players without rights.
+	RightsControl ControlLevel = 1 // RightsControl defines players with Nitro-granted rights.
+	OwnerControl  ControlLevel = 4 // OwnerControl defines the room owner.
+)
+
+// Level provides the flat control level of a relationship.
+func Level(rel Relationship) ControlLevel {
+	switch rel {
+	case Owner:
+		return OwnerControl
+	case Rights:
+		return RightsControl
+	default:
+		return NoControl
+	}
+}
+
+// Moderation provides the moderation rights of a relationship over the room.
+func Moderation(rel Relationship) *encode.ModerationRights {
+	var l encode.Level = encode.None
+	switch rel {
+	case Owner:
+		l = encode.Administrator
+	case Rights:
+		l = encode.Rights
+	}
+	return &encode.ModerationRights{Mute: l, Kick: l, Ban: l}
+}
+
+// Relationship provides the relationship of a player with the room.
+func (r *Room) Relationship(ctx context.Context, db *gorm.DB, p *user.Player) (Relationship, error) {
+
+	res := <-p.Record(ctx)
+	if res.Error != nil {
+		return Guest, res.Error
+	}
+
+	return VerifyUserRoomRelationship(ctx, db, r.Data(), *res.Data)
+
+}
+
+// IsController checks if the player is allowed to manage the room, as owner or with rights.
+func (r *Room) IsController(ctx context.Context, db *gorm.DB, p *user.Player) (bool, error) {
+
+	rel, err := r.Relationship(ctx, db, p)
+	if err != nil {
+		return false, err
+	}
+
+	return rel == Owner || rel == Rights, nil
+
+}
+
+// Controllers provides the in-game players allowed to manage the room.
+func (r *Room) Controllers(ctx context.Context, db *gorm.DB) ([]*user.Player, error) {
+
+	var cs []*user.Player
+	for _, p := range r.Online() {
+		ok, err := r.IsController(ctx, db, p)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			cs = append(cs, p)
+		}
+	}
+
+	return cs, nil
+
+}
+
+// Control updates the flat control level of an in-game player, notifying
+// the player of its rights and every player of the unit status.
+func (r *Room) Control(p *user.Player, level ControlLevel) {
+
+	r.mu.Lock()
+	if level == NoControl {
+		delete(p.Unit().Status, unit.Flat)
+	} else {
+		p.Unit().Status[unit.Flat] = strconv.Itoa(int(level))
+	}
+	enc, err := EncodeUnit(p.Unit())
+	r.mu.Unlock()
+
+	if level == NoControl {
+		p.Conn().SendPacket(&rights.NoRightsPacket{})
+	} else {
+		p.Conn().SendPacket(&rights.RightsLevelPacket{Level: int32(level)})
+	}
+
+	if level == OwnerControl {
+		p.Conn().SendPacket(&rights.RoomOwnerPacket{})
+	}
+
+	if err != nil {
+		r.logger.Error("Error encoding controlled unit", zap.String("identifier", p.Id), zap.Error(err))
+		return
+	}
+
+	r.Broadcast(&msg.UpdateStatusPacket{Units: []encode.UnitMessage{*enc}})
+
+}
@@ -0,0 +1,23 @@
+package room
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/room/encode"
+)
+
+// TestLevel verifies the flat control level of each relationship.
+func TestLevel(t *testing.T) {
+	assert.Equal(t, OwnerControl, Level(Owner))
+	assert.Equal(t, RightsControl, Level(Rights))
+	assert.Equal(t, NoControl, Level(Access))
+	assert.Equal(t, NoControl, Level(Guest))
+}
+
+// TestModeration verifies the moderation rights of each relationship.
+func TestModeration(t *testing.T) {
+	assert.Equal(t, &encode.ModerationRights{Mute: encode.Administrator, Kick: encode.Administrator, Ban: encode.Administrator}, Moderation(Owner))
+	assert.Equal(t, &encode.ModerationRights{Mute: encode.Rights, Kick: encode.Rights, Ban: encode.Rights}, Moderation(Rights))
+	assert.Equal(t, &encode.ModerationRights{}, Moderation(Guest))
+}
@@ -0,0 +1,79 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"pixels-emulator/core/database"
+	"pixels-emulator/core/model"
+)
+
+// CreationLimitReached is the Nitro reason of a rejected room creation by owning too many rooms.
+const CreationLimitReached = 1
+
+// ErrCreationLimit rejects rooms created by owners of too many rooms.
+var ErrCreationLimit = errors.New("room creation limit reached")
+
+// Layout provides an essential height map by its slug, nil if not available for all players.
+func Layout(ctx context.Context, db *gorm.DB, slug string) (*model.HeightMap, error) {
+	svc := &database.ModelService[model.HeightMap]{DB: db}
+	res, err := svc.FindByQuerySync(ctx, map[string]interface{}{"slug": slug, "essential": true})
+	if err != nil || len(res) == 0 {
+		return nil, err
+	}
+	return &res[0], nil
+}
+
+// Owned provides the amount of rooms owned by a user.
+func Owned(ctx context.Context, db *gorm.DB, owner uint) (int64, error) {
+	var n int64
+	err := db.WithContext(ctx).Model(&model.Room{}).Where("owner_id = ?", owner).Count(&n).Error
+	return n, err
+}
+
+// Create persists a new room along its configuration in one transaction, unless the
+// owner already owns the limit of rooms. The owner is locked while the rooms are counted,
+// so concurrent creations can not exceed the limit.
+// Rooms created by players are never public, as public rooms skip access checks.
+func Create(ctx context.Context, db *gorm.DB, r *model.Room, limit int) error {
+
+	svc := &database.ModelService[model.Room]{DB: db}
+	tx, err := svc.BeginTransactionSync(ctx)
+	if err != nil {
+		return err
+	}
+
+	var owner []uint
+	err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Model(&model.User{}).Where("id = ?", r.OwnerID).Pluck("id", &owner).Error
+
+	var owned int64
+	if err == nil {
+		err = tx.Model(&model.Room{}).Where("owner_id = ?", r.OwnerID).Count(&owned).Error
+	}
+
+	if err == nil && owned >= int64(limit) {
+		err = ErrCreationLimit
+	}
+
+	// Zero values are replaced by column defaults on creation, so visibility is set afterward.
+	if err == nil {
+		err = tx.Omit(clause.Associations).Create(r).Error
+	}
+	if err == nil {
+		err = tx.Model(r).Update("is_public", false).Error
+	}
+
+	if err == nil {
+		r.Configuration.RoomID = r.ID
+		err = tx.Omit(clause.Associations).Create(&r.Configuration).Error
+	}
+
+	if err != nil {
+		_ = svc.RollbackTransactionSync(tx)
+		return err
+	}
+
+	return svc.CommitTransactionSync(tx)
+
+}
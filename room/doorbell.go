@@ -0,0 +1,66 @@
+package room
+
+import (
+	"pixels-emulator/room/message/doorbell"
+	"pixels-emulator/user"
+	"time"
+)
+
+// DoorbellTimeout defines how long a ring waits for an answer.
+const DoorbellTimeout = time.Minute
+
+// Ring defines a pending doorbell request of a player.
+type Ring struct {
+	Player *user.Player // Player is the ringing player.
+	Since  time.Time    // Since is the moment when the doorbell was rung.
+}
+
+// IsRinging checks if the player is waiting for a doorbell answer.
+func (r *Room) IsRinging(p *user.Player) bool {
+	r.ringMu.Lock()
+	defer r.ringMu.Unlock()
+	_, ex := r.rings[p.Id]
+	return ex
+}
+
+// Ring registers a doorbell request of the player, notifying it to await the answer.
+func (r *Room) Ring(p *user.Player) *Ring {
+	r.ringMu.Lock()
+	ring := &Ring{Player: p, Since: time.Now()}
+	r.rings[p.Id] = ring
+	r.ringMu.Unlock()
+
+	p.Conn().SendPacket(&doorbell.DoorbellPacket{})
+	return ring
+}
+
+// Ringer provides the pending ring of a player by its username.
+func (r *Room) Ringer(username string) *Ring {
+	r.ringMu.Lock()
+	defer r.ringMu.Unlock()
+	for _, ring := range r.rings {
+		if ring.Player.Username == username {
+			return ring
+		}
+	}
+	return nil
+}
+
+// Answer resolves a pending ring, notifying the ringing player. Returns false
+// if the ring was already resolved, so answers and timeouts are only processed once.
+func (r *Room) Answer(ring *Ring, accept bool) bool {
+	r.ringMu.Lock()
+	if r.rings[ring.Player.Id] != ring {
+		r.ringMu.Unlock()
+		return false
+	}
+	delete(r.rings, ring.Player.Id)
+	r.ringMu.Unlock()
+
+	if accept {
+		ring.Player.Conn().SendPacket(&doorbell.DoorbellAcceptedPacket{})
+	} else {
+		ring.Player.Conn().SendPacket(&doorbell.DoorbellRejectedPacket{})
+	}
+	return true
+}
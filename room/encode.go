@@ -2,14 +2,17 @@ package room
 
 import (
 	"context"
+	"maps"
 	"pixels-emulator/core/model"
 	"pixels-emulator/room/encode"
 	"pixels-emulator/room/unit"
 	"pixels-emulator/user"
 	"strconv"
+	"time"
 )
 
-// EncodeUnit codifies a room unit into a binary unit wrapper.
+// EncodeUnit codifies a room unit into a binary unit wrapper. The statuses
+// are copied, as the packet is serialized after the unit keeps walking.
 func EncodeUnit(unit *unit.Unit) (*encode.UnitMessage, error) {
 
 	c := unit.Current
@@ -22,7 +25,7 @@ func EncodeUnit(unit *unit.Unit) (*encode.UnitMessage, error) {
 		Z:      int32(c.Z()),
 		Head:   int32(h),
 		Body:   int32(b),
-		Status: unit.Status,
+		Status: maps.Clone(unit.Status),
 	}, nil
 
 }
@@ -38,43 +41,40 @@ func EncodeSettings(c *model.RoomConfiguration) *encode.RoomChatSettings {
 	}
 }
 
+// EncodeRoom creates a protocol version of the room data, with the players of the ephemeral room if loaded.
 func EncodeRoom(r *model.Room, t *Room) *encode.RoomData {
 
-	var s encode.Door
-	switch r.State {
-	case "closed":
-		s = encode.Locked
-		break
-	case "password_Protected":
-		s = encode.PasswordProtected
-		break
-	default:
-		s = encode.Open
-		break
-	} // TODO: Other two cases?
+	var users int32
+	if t != nil {
+		users = int32(t.Population())
+	}
 
 	enc := &encode.RoomData{
-		ID:                int32(r.ID),
-		Name:              r.Name,
-		OwnerID:           int32(r.OwnerID),
-		OwnerName:         r.Owner.Username,
-		IsPublic:          r.IsPublic,
-		DoorMode:          s,
-		UserCount:         int32(len(t.Players)),
-		UserMax:           int32(r.UsersMax),
-		Description:       r.Description,
-		Score:             0, // TODO: Get this
-		Category:          0,
-		Tags:              make([]string, 0),
-		GuildID:           0,
-		GuildName:         "",
-		GuildBadge:        "",
-		PromotionTitle:    "",
-		PromotionDesc:     "",
-		PromotionTime:     120,
-		Thumbnail:         "",
-		FeaturedPromotion: false,
-		AllowPets:         r.Configuration.AllowPets, // End of get this
+		ID:          int32(r.ID),
+		Name:        r.Name,
+		OwnerID:     int32(r.OwnerID),
+		OwnerName:   r.Owner.Username,
+		IsPublic:    r.IsPublic,
+		DoorMode:    DoorOf(r.State),
+		UserCount:   users,
+		UserMax:     int32(r.UsersMax),
+		Description: r.Description,
+		Score:       int32(r.Score),
+		Category:    int32(r.CategoryID),
+		Tags:        SplitTags(r.Tags),
+		GuildID:     0,
+		GuildName:   "",
+		GuildBadge:  "",
+		Thumbnail:   "",
+		AllowPets:   r.Configuration.AllowPets, // End of get this
+	}
+
+	// Promoted rooms show their event in the navigator and on entry.
+	if now := time.Now(); Promoted(r, now) {
+		enc.PromotionTitle = r.Promotion.Title
+		enc.PromotionDesc = r.Promotion.Description
+		enc.PromotionTime = int32(r.Promotion.Expires.Sub(now).Minutes())
+		enc.FeaturedPromotion = true
 	}
 
 	return enc
@@ -99,7 +99,7 @@ func EncodePlayerDetail(ctx context.Context, p *user.Player, r *Room) (*encode.U
 		Username:  u.Username,
 		Custom:    u.Motto,
 		Figure:    u.Look,
-		RoomIndex: int32(r.Id),
+		RoomIndex: p.Unit().Id,
 		UnitX:     int32(p.Unit().Current.X()),
 		UnitY:     int32(p.Unit().Current.Y()),
 		UnitZ:     int32(p.Unit().Current.Z()),
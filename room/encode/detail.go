@@ -24,7 +24,7 @@ type UnitDetail struct {
 	Username                 string   // Username defines the name of the unit
 	Custom                   string   // Custom defines custom information depending on the unit.
 	Figure                   string   // Figure defines the look string.
-	RoomIndex                int32    // RoomIndex defines the room id of the unit
+	RoomIndex                int32    // RoomIndex defines the unit identifier inside the room
 	UnitX, UnitY, UnitZ, Rot int32    // UnitX, UnitY, UnitZ and Rot defines the unit position and rotation.
 	Type                     UnitType // Type defines the unit type to parse further data
 }
@@ -0,0 +1,180 @@
+package encode
+
+import (
+	"pixels-emulator/core/protocol"
+	"strconv"
+)
+
+// Usage defines who can use an item.
+type Usage int32
+
+const (
+	UsageNobody     Usage = iota // UsageNobody defines items which can not be used.
+	UsageController              // UsageController defines items used by the room controllers.
+	UsageEverybody               // UsageEverybody defines items used by any player.
+)
+
+// LegacyData is the Nitro format of the item states sent as a single string.
+const LegacyData int32 = 0
+
+// LimitedData flags the item states followed by the limited edition serial and size.
+const LimitedData int32 = 256
+
+// NoExpiration defines items which are never removed.
+const NoExpiration int32 = -1
+
+// FloorItem represents an item placed on the room tiles.
+type FloorItem struct {
+	protocol.Encodable
+	ItemId      int32   // ItemId is the unique identifier of the item.
+	Sprite      int32   // Sprite is the identifier of the furniture in the furnidata.
+	X           int32   // X is the coordinate of the item along the X axis.
+	Y           int32   // Y is the coordinate of the item along the Y axis.
+	Rotation    int32   // Rotation is the direction the item faces.
+	Z           float64 // Z is the height where the item is placed.
+	StackHeight float64 // StackHeight is the height added by the item.
+	Extra       int32   // Extra is an additional value used by some interactions.
+	Data        string  // Data is the state of the item.
+	Serial      int32   // Serial is the limited edition number of the item, zero if not limited.
+	Series      int32   // Series is the amount of items of the limited edition.
+	Expiration  int32   // Expiration are the seconds until the item is removed.
+	Usage       Usage   // Usage defines who can use the item.
+	OwnerId     int32   // OwnerId is the identifier of the item owner.
+}
+
+// Encode adds current data to a packet. Limited items are flagged and send their
+// serial and edition size after their state.
+func (e *FloorItem) Encode(pck *protocol.RawPacket) {
+	pck.AddInt(e.ItemId)
+	pck.AddInt(e.Sprite)
+	pck.AddInt(e.X)
+	pck.AddInt(e.Y)
+	pck.AddInt(e.Rotation)
+	pck.AddString(strconv.FormatFloat(e.Z, 'f', -1, 64))
+	pck.AddString(strconv.FormatFloat(e.StackHeight, 'f', -1, 64))
+	pck.AddInt(e.Extra)
+	if e.Series > 0 {
+		pck.AddInt(LegacyData | LimitedData)
+		pck.AddString(e.Data)
+		pck.AddInt(e.Serial)
+		pck.AddInt(e.Series)
+	} else {
+		pck.AddInt(LegacyData)
+		pck.AddString(e.Data)
+	}
+	pck.AddInt(e.Expiration)
+	pck.AddInt(int32(e.Usage))
+	pck.AddInt(e.OwnerId)
+}
+
+// Decode reads the item from a packet.
+func (e *FloorItem) Decode(pck *protocol.RawPacket) error {
+
+	ints := []*int32{&e.ItemId, &e.Sprite, &e.X, &e.Y, &e.Rotation}
+	for _, v := range ints {
+		n, err := pck.ReadInt()
+		if err != nil {
+			return err
+		}
+		*v = n
+	}
+
+	for _, v := range []*float64{&e.Z, &e.StackHeight} {
+		s, err := pck.ReadString()
+		if err != nil {
+			return err
+		}
+		if *v, err = strconv.ParseFloat(s, 64); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if e.Extra, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	flags, err := pck.ReadInt()
+	if err != nil {
+		return err
+	}
+	if e.Data, err = pck.ReadString(); err != nil {
+		return err
+	}
+	if flags&LimitedData > 0 {
+		if e.Serial, err = pck.ReadInt(); err != nil {
+			return err
+		}
+		if e.Series, err = pck.ReadInt(); err != nil {
+			return err
+		}
+	}
+	if e.Expiration, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	usage, err := pck.ReadInt()
+	if err != nil {
+		return err
+	}
+	e.Usage = Usage(usage)
+	e.OwnerId, err = pck.ReadInt()
+	return err
+
+}
+
+// WallItem represents an item placed on the room walls.
+type WallItem struct {
+	protocol.Encodable
+	ItemId     int32  // ItemId is the unique identifier of the item.
+	Sprite     int32  // Sprite is the identifier of the furniture in the furnidata.
+	Position   string // Position is the location of the item on the wall.
+	Data       string // Data is the state of the item.
+	Expiration int32  // Expiration are the seconds until the item is removed.
+	Usage      Usage  // Usage defines who can use the item.
+	OwnerId    int32  // OwnerId is the identifier of the item owner.
+}
+
+// Encode adds current data to a packet. Wall items identifiers are sent as text.
+func (e *WallItem) Encode(pck *protocol.RawPacket) {
+	pck.AddString(strconv.Itoa(int(e.ItemId)))
+	pck.AddInt(e.Sprite)
+	pck.AddString(e.Position)
+	pck.AddString(e.Data)
+	pck.AddInt(e.Expiration)
+	pck.AddInt(int32(e.Usage))
+	pck.AddInt(e.OwnerId)
+}
+
+// Decode reads the item from a packet.
+func (e *WallItem) Decode(pck *protocol.RawPacket) error {
+
+	id, err := pck.ReadString()
+	if err != nil {
+		return err
+	}
+	n, err := strconv.Atoi(id)
+	if err != nil {
+		return err
+	}
+	e.ItemId = int32(n)
+
+	if e.Sprite, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	if e.Position, err = pck.ReadString(); err != nil {
+		return err
+	}
+	if e.Data, err = pck.ReadString(); err != nil {
+		return err
+	}
+	if e.Expiration, err = pck.ReadInt(); err != nil {
+		return err
+	}
+	usage, err := pck.ReadInt()
+	if err != nil {
+		return err
+	}
+	e.Usage = Usage(usage)
+	e.OwnerId, err = pck.ReadInt()
+	return err
+
+}
@@ -0,0 +1,58 @@
+package encode
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"testing"
+)
+
+// TestFloorItem_Encode check if encode is done correctly.
+func TestFloorItem_Encode(t *testing.T) {
+
+	item := &FloorItem{ItemId: 5, Sprite: 13, X: 3, Y: 4, Rotation: 2, Z: 1.5, StackHeight: 0.7, Data: "1", Expiration: NoExpiration, Usage: UsageController, OwnerId: 8}
+
+	pck := protocol.NewPacket(100)
+	item.Encode(&pck)
+
+	dec, err := protocol.FromBytes(pck.ToBytes())
+	assert.NoError(t, err, "Raw decoding must not have an error")
+
+	decItem := &FloorItem{}
+	assert.NoError(t, decItem.Decode(dec), "Decoding must not have an error")
+	assert.Equal(t, item, decItem)
+
+}
+
+// TestFloorItem_EncodeLimited check if limited items send their serial.
+func TestFloorItem_EncodeLimited(t *testing.T) {
+
+	item := &FloorItem{ItemId: 5, Sprite: 13, Data: "0", Serial: 7, Series: 100, Expiration: NoExpiration}
+
+	pck := protocol.NewPacket(100)
+	item.Encode(&pck)
+
+	dec, err := protocol.FromBytes(pck.ToBytes())
+	assert.NoError(t, err, "Raw decoding must not have an error")
+
+	decItem := &FloorItem{}
+	assert.NoError(t, decItem.Decode(dec), "Decoding must not have an error")
+	assert.Equal(t, item, decItem)
+
+}
+
+// TestWallItem_Encode check if encode is done correctly.
+func TestWallItem_Encode(t *testing.T) {
+
+	item := &WallItem{ItemId: 7, Sprite: 4001, Position: ":w=0,2 l=11,31 r", Data: "0", Expiration: NoExpiration, Usage: UsageNobody, OwnerId: 8}
+
+	pck := protocol.NewPacket(100)
+	item.Encode(&pck)
+
+	dec, err := protocol.FromBytes(pck.ToBytes())
+	assert.NoError(t, err, "Raw decoding must not have an error")
+
+	decItem := &WallItem{}
+	assert.NoError(t, decItem.Decode(dec), "Decoding must not have an error")
+	assert.Equal(t, item, decItem)
+
+}
@@ -0,0 +1,35 @@
+package event
+
+import (
+	"pixels-emulator/core/event"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/message/chat"
+)
+
+const RoomChatEventName = "room.chat"
+
+// RoomChatEvent represents a chat message said by a player inside a room.
+// Listeners can mutate the message or cancel its delivery.
+type RoomChatEvent struct {
+	*event.CancellableEvent                     // Extends functionality for cancellation.
+	Conn                    protocol.Connection // Conn represents the connection of the speaker.
+	Room                    uint                // Room represents the room where the message is said.
+	Type                    chat.Type           // Type represents the delivery of the message.
+	Message                 string              // Message represents the text said.
+	Bubble                  int32               // Bubble represents the chat bubble style.
+	Target                  string              // Target represents the username of the receiver on whispers.
+}
+
+// NewRoomChatEvent creates a new instance.
+func NewRoomChatEvent(conn protocol.Connection, room uint, t chat.Type, msg string, bubble int32, target string, owner uint16, metadata map[string]string) *RoomChatEvent {
+	ce := event.NewCancellable(owner, metadata)
+	return &RoomChatEvent{
+		CancellableEvent: ce.(*event.CancellableEvent),
+		Conn:             conn,
+		Room:             room,
+		Type:             t,
+		Message:          msg,
+		Bubble:           bubble,
+		Target:           target,
+	}
+}
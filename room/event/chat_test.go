@@ -0,0 +1,21 @@
+package event
+
+import (
+	"github.com/stretchr/testify/assert"
+	mockproto "pixels-emulator/core/protocol/mock"
+	"pixels-emulator/room/message/chat"
+	"testing"
+)
+
+func TestNewRoomChatEvent(t *testing.T) {
+	con := &mockproto.MockConnection{}
+	ev := NewRoomChatEvent(con, 1, chat.Whisper, "hello", 2, "john", 0, make(map[string]string))
+
+	assert.NotNil(t, ev.Conn, "Connection must be passed")
+	assert.Equal(t, uint(1), ev.Room, "Room id must match")
+	assert.Equal(t, chat.Whisper, ev.Type, "Chat type must match")
+	assert.Equal(t, "hello", ev.Message, "Message must match")
+	assert.Equal(t, int32(2), ev.Bubble, "Bubble must match")
+	assert.Equal(t, "john", ev.Target, "Target must match")
+	assert.False(t, ev.IsCancelled(), "Event must not be cancelled")
+}
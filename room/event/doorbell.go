@@ -0,0 +1,26 @@
+package event
+
+import (
+	"pixels-emulator/core/event"
+	"pixels-emulator/core/protocol"
+)
+
+const RoomDoorbellEventName = "room.doorbell"
+
+// RoomDoorbellEvent represents a connection ringing the doorbell
+// of a locked room. Cancelling it rejects the entry without ringing.
+type RoomDoorbellEvent struct {
+	*event.CancellableEvent                     // Extends functionality for cancellation.
+	Conn                    protocol.Connection // Conn represents the connection which is ringing.
+	Room                    uint                // Room represents the room being rung.
+}
+
+// NewRoomDoorbellEvent creates a new instance.
+func NewRoomDoorbellEvent(conn protocol.Connection, room uint, owner uint16, metadata map[string]string) *RoomDoorbellEvent {
+	ce := event.NewCancellable(owner, metadata)
+	return &RoomDoorbellEvent{
+		CancellableEvent: ce.(*event.CancellableEvent),
+		Conn:             conn,
+		Room:             room,
+	}
+}
@@ -0,0 +1,16 @@
+package event
+
+import (
+	"github.com/stretchr/testify/assert"
+	mockproto "pixels-emulator/core/protocol/mock"
+	"testing"
+)
+
+func TestNewRoomDoorbellEvent(t *testing.T) {
+	con := &mockproto.MockConnection{}
+	ev := NewRoomDoorbellEvent(con, 3, 0, make(map[string]string))
+
+	assert.NotNil(t, ev.Conn, "Connection must be passed")
+	assert.Equal(t, uint(3), ev.Room, "Room id must match")
+	assert.False(t, ev.IsCancelled(), "Event must not be cancelled")
+}
@@ -0,0 +1,24 @@
+package event
+
+import (
+	"pixels-emulator/core/event"
+)
+
+const RoomEnterEventName = "room.enter"
+
+// RoomEnterEvent is triggered when a player has entered a room, being placed on its tiles.
+type RoomEnterEvent struct {
+	*event.BaseEvent        // Extends base event functionality.
+	Room             uint   // Room holds the identifier of the room entered.
+	Player           string // Player holds the identifier of the player who entered.
+}
+
+// NewRoomEnterEvent creates a new RoomEnterEvent.
+func NewRoomEnterEvent(room uint, player string, owner uint16, metadata map[string]string) *RoomEnterEvent {
+	ce := event.New(owner, metadata)
+	return &RoomEnterEvent{
+		BaseEvent: ce.(*event.BaseEvent),
+		Room:      room,
+		Player:    player,
+	}
+}
@@ -0,0 +1,13 @@
+package event
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewRoomEnterEvent(t *testing.T) {
+	ev := NewRoomEnterEvent(1, "2", 0, make(map[string]string))
+
+	assert.Equal(t, uint(1), ev.Room, "Room id must match")
+	assert.Equal(t, "2", ev.Player, "Player id must match")
+}
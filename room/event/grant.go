@@ -12,8 +12,9 @@ const RoomLoadRequestEventName = "room.load"
 // to be allowed to join a room lifecycle, and the room must be loaded.
 type RoomLoadRequestEvent struct {
 	*event.BaseEvent
-	Conn protocol.Connection // Conn represents the connection which is joining the room.
-	Room uint                // Room where the access is granted.
+	Conn      protocol.Connection // Conn represents the connection which is joining the room.
+	Room      uint                // Room where the access is granted.
+	SkipQueue bool                // SkipQueue defines if the user can enter regardless of the room capacity.
 }
 
 // NewRoomLoadRequestEvent creates a new instance.
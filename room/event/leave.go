@@ -0,0 +1,24 @@
+package event
+
+import (
+	"pixels-emulator/core/event"
+)
+
+const RoomLeaveEventName = "room.leave"
+
+// RoomLeaveEvent is triggered when a player has left a room.
+type RoomLeaveEvent struct {
+	*event.BaseEvent        // Extends base event functionality.
+	Room             uint   // Room holds the identifier of the room left.
+	Player           string // Player holds the identifier of the player who left.
+}
+
+// NewRoomLeaveEvent creates a new RoomLeaveEvent.
+func NewRoomLeaveEvent(room uint, player string, owner uint16, metadata map[string]string) *RoomLeaveEvent {
+	ce := event.New(owner, metadata)
+	return &RoomLeaveEvent{
+		BaseEvent: ce.(*event.BaseEvent),
+		Room:      room,
+		Player:    player,
+	}
+}
@@ -0,0 +1,26 @@
+package event
+
+import (
+	"pixels-emulator/core/event"
+)
+
+const RoomRightsChangedEventName = "room.rights.changed"
+
+// RoomRightsChangedEvent is triggered when a user receives or loses rights over a room.
+type RoomRightsChangedEvent struct {
+	*event.BaseEvent      // Extends base event functionality.
+	Room             uint // Room holds the identifier of the room.
+	User             uint // User holds the identifier of the user whose rights changed.
+	Granted          bool // Granted defines if the rights were given or removed.
+}
+
+// NewRoomRightsChangedEvent creates a new RoomRightsChangedEvent.
+func NewRoomRightsChangedEvent(room, user uint, granted bool, owner uint16, metadata map[string]string) *RoomRightsChangedEvent {
+	ce := event.New(owner, metadata)
+	return &RoomRightsChangedEvent{
+		BaseEvent: ce.(*event.BaseEvent),
+		Room:      room,
+		User:      user,
+		Granted:   granted,
+	}
+}
@@ -0,0 +1,14 @@
+package event
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewRoomRightsChangedEvent(t *testing.T) {
+	ev := NewRoomRightsChangedEvent(1, 2, true, 0, make(map[string]string))
+
+	assert.Equal(t, uint(1), ev.Room, "Room id must match")
+	assert.Equal(t, uint(2), ev.User, "User id must match")
+	assert.True(t, ev.Granted, "Rights must be granted")
+}
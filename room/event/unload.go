@@ -0,0 +1,22 @@
+package event
+
+import (
+	"pixels-emulator/core/event"
+)
+
+const RoomUnloadEventName = "room.unload"
+
+// RoomUnloadEvent is triggered when a room has been removed from memory.
+type RoomUnloadEvent struct {
+	*event.BaseEvent      // Extends base event functionality.
+	RoomId           uint // RoomId holds the identifier of the room unloaded.
+}
+
+// NewRoomUnloadEvent creates a new RoomUnloadEvent.
+func NewRoomUnloadEvent(id uint, owner uint16, metadata map[string]string) *RoomUnloadEvent {
+	ce := event.New(owner, metadata)
+	return &RoomUnloadEvent{
+		BaseEvent: ce.(*event.BaseEvent),
+		RoomId:    id,
+	}
+}
@@ -0,0 +1,11 @@
+package event
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewRoomUnloadEvent(t *testing.T) {
+	ev := NewRoomUnloadEvent(1, 0, make(map[string]string))
+	assert.Equal(t, uint(1), ev.RoomId, "Room id must match")
+}
@@ -0,0 +1,205 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/core/database"
+	"pixels-emulator/core/model"
+	"pixels-emulator/room/message"
+	"pixels-emulator/room/message/floorplan"
+	"pixels-emulator/room/path"
+	"pixels-emulator/user"
+	"strconv"
+	"strings"
+)
+
+const (
+	MinWallHeight = -1 // MinWallHeight defines the automatic wall height.
+	MaxWallHeight = 15 // MaxWallHeight defines the highest wall allowed.
+)
+
+// ErrFloorPlanItems rejects floor plans removing the tiles under placed items.
+var ErrFloorPlanItems = errors.New("floor plan removes tiles under placed items")
+
+// FloorPlanSlug provides the slug of the custom heightmap of a room.
+func FloorPlanSlug(id uint) string {
+	return "custom_" + strconv.Itoa(int(id))
+}
+
+// FloorPlan transforms a heightmap drawn with the floor plan editor into a room heightmap.
+// Nitro separates the rows by carriage returns, while stored heightmaps use escaped line breaks.
+func (r *Room) FloorPlan(pck *floorplan.SaveFloorPlanPacket) *model.HeightMap {
+
+	var rows []string
+	for _, row := range strings.Split(strings.ReplaceAll(pck.Map, "\n", ""), "\r") {
+		if row != "" {
+			rows = append(rows, row)
+		}
+	}
+
+	return &model.HeightMap{
+		Slug:          FloorPlanSlug(r.Id),
+		DoorX:         int(pck.DoorX),
+		DoorY:         int(pck.DoorY),
+		DoorDirection: int(clamp(pck.DoorDirection, 0, 7)),
+		Heightmap:     strings.Join(rows, "\\r\\n"),
+		Essential:     false,
+	}
+
+}
+
+// Occupied provides the tiles which can not be removed from the layout, as items are placed on them.
+func (r *Room) Occupied() []floorplan.Tile {
+
+	seen := make(map[[2]int]struct{})
+	var tiles []floorplan.Tile
+	for _, i := range r.Items(model.FloorItem) {
+		w, l := Size(i)
+		for x := i.X; x < i.X+w; x++ {
+			for y := i.Y; y < i.Y+l; y++ {
+				if _, ok := seen[[2]int{x, y}]; ok {
+					continue
+				}
+				seen[[2]int{x, y}] = struct{}{}
+				tiles = append(tiles, floorplan.Tile{X: int32(x), Y: int32(y)})
+			}
+		}
+	}
+	return tiles
+
+}
+
+// fits checks a layout keeps every tile covered by the floor items, away from its door.
+func (r *Room) fits(l *path.Layout) error {
+
+	door := l.DoorTile()
+	for _, t := range r.Occupied() {
+		x, y := int(t.X), int(t.Y)
+		if !l.TileExists(x, y) {
+			return ErrFloorPlanItems
+		}
+		if tile := l.GetTile(x, y); tile.State == path.Invalid || tile == door {
+			return ErrFloorPlanItems
+		}
+	}
+	return nil
+
+}
+
+// SaveFloorPlan persists a validated custom heightmap as the room layout, reusing the
+// previous custom heightmap of the room if any. Walls and floor are updated as well.
+// The room is persisted along, keeping the previous layout if it can not be saved.
+// Layouts removing the tiles under the floor items are rejected with ErrFloorPlanItems.
+func (r *Room) SaveFloorPlan(ctx context.Context, db *gorm.DB, hMap *model.HeightMap, l *path.Layout, pck *floorplan.SaveFloorPlanPacket) error {
+
+	// Items can not be placed while the new layout is checked and installed.
+	r.placeMu.Lock()
+	defer r.placeMu.Unlock()
+
+	if err := r.fits(l); err != nil {
+		return err
+	}
+
+	r.mu.RLock()
+	previous := r.lData
+	r.mu.RUnlock()
+
+	// The layout is only replaced once the heightmap and the room are persisted.
+	if err := r.saveLayout(ctx, db, previous, hMap, pck); err != nil {
+		return err
+	}
+
+	r.reshape(*hMap, l)
+	return nil
+
+}
+
+// saveLayout persists the custom heightmap along the room data using it, updating
+// the room data once both are stored.
+func (r *Room) saveLayout(ctx context.Context, db *gorm.DB, previous model.HeightMap, hMap *model.HeightMap, pck *floorplan.SaveFloorPlanPacket) error {
+
+	r.saveMu.Lock()
+	defer r.saveMu.Unlock()
+
+	d := r.Data()
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+
+		svc := &database.ModelService[model.HeightMap]{DB: tx}
+
+		var err error
+		if previous.Slug == hMap.Slug {
+			hMap.ID = previous.ID
+			hMap.CreatedAt = previous.CreatedAt
+			err = svc.UpdateSync(ctx, hMap)
+		} else {
+			err = svc.CreateSync(ctx, hMap)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		d.LayoutId = hMap.ID
+		d.Layout = *hMap
+		c := &d.Configuration
+		c.WallThickness = float64(clamp(pck.WallThickness, -2, 1))
+		c.FloorThickness = float64(clamp(pck.FloorThickness, -2, 1))
+		c.WallHeight = float64(clamp(pck.WallHeight, MinWallHeight, MaxWallHeight))
+
+		return r.save(ctx, tx, &d)
+
+	})
+
+	if err != nil {
+		return err
+	}
+
+	r.update(func(live *model.Room) {
+		own(live, d)
+	})
+	return nil
+
+}
+
+// reshape replaces the room layout, opening again the room to the in-game players
+// from the door so their clients load the new heightmap without reconnecting.
+// Every player is placed before the units are sent, so each client syncs them once.
+func (r *Room) reshape(hMap model.HeightMap, l *path.Layout) {
+
+	r.mu.Lock()
+	for _, p := range r.Players {
+		if t := p.Unit().GetCurrentTile(r.l); t != nil {
+			t.RemoveUnit(p.Id)
+		}
+		p.Unit().Request = nil
+	}
+
+	r.lData = hMap
+	r.l = l
+	r.stackAll()
+
+	players := make([]*user.Player, 0, len(r.Players))
+	for _, p := range r.Players {
+		r.settle(p, nil)
+		players = append(players, p)
+	}
+	r.mu.Unlock()
+
+	// Units are encoded while the cycle can not move them.
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, p := range players {
+		p.Conn().SendPacket(&message.RoomReadyPacket{Room: int32(r.Id), Layout: l.Slug()})
+		if err := SendUnitDetailPacket(context.Background(), r, players, p); err != nil {
+			r.logger.Error("Error while reshaping room session", zap.String("identifier", p.Id), zap.Error(err))
+			continue
+		}
+		if err := SendUnitSyncPacket(players, p); err != nil {
+			r.logger.Error("Error while reshaping room session", zap.String("identifier", p.Id), zap.Error(err))
+		}
+	}
+
+}
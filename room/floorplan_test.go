@@ -0,0 +1,109 @@
+package room
+
+import (
+	"pixels-emulator/core/database"
+	mockdb "pixels-emulator/core/database/mock"
+	"pixels-emulator/core/model"
+	protocolMock "pixels-emulator/core/protocol/mock"
+	"pixels-emulator/core/util"
+	"pixels-emulator/room/message"
+	"pixels-emulator/room/message/floorplan"
+	"pixels-emulator/room/message/unit"
+	"pixels-emulator/room/path"
+	"pixels-emulator/user"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// TestRoom_FloorPlan verifies the editor heightmaps are stored as room heightmaps.
+func TestRoom_FloorPlan(t *testing.T) {
+	r := &Room{Id: 3}
+	hMap := r.FloorPlan(&floorplan.SaveFloorPlanPacket{Map: "xxx\rx0x\rx0x\r", DoorX: 1, DoorY: 1, DoorDirection: 9})
+
+	assert.Equal(t, "custom_3", hMap.Slug)
+	assert.Equal(t, "xxx\\r\\nx0x\\r\\nx0x", hMap.Heightmap)
+	assert.Equal(t, 7, hMap.DoorDirection)
+	assert.False(t, hMap.Essential)
+
+	l, err := path.ValidateHeightMap(hMap)
+	assert.NoError(t, err)
+	_, x, y := l.GetSizes()
+	assert.Equal(t, 3, x)
+	assert.Equal(t, 3, y)
+}
+
+// TestRoom_Reshape verifies every player reloads the new layout from the door,
+// receiving the units of the room once.
+func TestRoom_Reshape(t *testing.T) {
+	r := testRoom(t)
+
+	conns := make([]*protocolMock.MockConnection, 2)
+	for n := range conns {
+		id := uint(n + 1)
+		u := &model.User{BaseModel: database.BaseModel{ID: id}}
+		svc := &mockdb.ModelServiceMock[model.User]{}
+		for range conns {
+			svc.On("Get", mock.Anything, id).Return(util.MockAsyncResponse(u, nil)).Once()
+		}
+
+		conns[n] = &protocolMock.MockConnection{}
+		conns[n].On("SendPacket", mock.Anything).Return()
+		conns[n].On("SendRaw", mock.Anything, mock.Anything, mock.Anything).Return()
+
+		p := user.Load(u, conns[n], nil, svc)
+		r.Players[p.Id] = p
+		r.settle(p, nil)
+	}
+
+	hMap := model.HeightMap{Slug: "custom_1", Heightmap: "000\\r\\n000\\r\\n000", DoorX: 2, DoorY: 2}
+	l, err := path.NewLayout(&hMap)
+	assert.NoError(t, err)
+	r.reshape(hMap, l)
+
+	for _, p := range r.Online() {
+		assert.Equal(t, int16(2), p.Unit().Current.X())
+		assert.Equal(t, int16(2), p.Unit().Current.Y())
+	}
+
+	for _, c := range conns {
+		ready, syncs := 0, 0
+		for _, call := range c.Calls {
+			switch pck := call.Arguments.Get(0).(type) {
+			case *message.RoomReadyPacket:
+				ready++
+			case *unit.UpdateStatusPacket:
+				syncs++
+				assert.Len(t, pck.Units, 2)
+			}
+		}
+		assert.Equal(t, 1, ready)
+		assert.Equal(t, 1, syncs, "units are synced once after every player is placed")
+		c.AssertNumberOfCalls(t, "SendRaw", 1)
+	}
+}
+
+// TestRoom_FloorPlanItems verifies the layouts must keep the tiles under the floor items.
+func TestRoom_FloorPlanItems(t *testing.T) {
+	r := placementRoom(t, "000\\r\\n000\\r\\n000")
+	sofa := furni(1, 1, 1, 0, 0, model.ItemDefinition{Width: 2, Length: 1})
+	chair := furni(2, 1, 1, 1, 0, model.ItemDefinition{AllowSit: true})
+	r.items[sofa.ID], r.items[chair.ID] = sofa, chair
+
+	assert.ElementsMatch(t, []floorplan.Tile{{X: 1, Y: 1}, {X: 2, Y: 1}}, r.Occupied())
+
+	for name, hMap := range map[string]model.HeightMap{
+		"void tile":    {Heightmap: "000\\r\\n00x\\r\\n000"},
+		"missing tile": {Heightmap: "00\\r\\n00\\r\\n00"},
+		"door tile":    {Heightmap: "000\\r\\n000\\r\\n000", DoorX: 2, DoorY: 1},
+	} {
+		l, err := path.ValidateHeightMap(&hMap)
+		assert.NoError(t, err, name)
+		assert.ErrorIs(t, r.fits(l), ErrFloorPlanItems, name)
+	}
+
+	l, err := path.ValidateHeightMap(&model.HeightMap{Heightmap: "x00\\r\\nx00\\r\\n000", DoorX: 2, DoorY: 2})
+	assert.NoError(t, err)
+	assert.NoError(t, r.fits(l))
+}
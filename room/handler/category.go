@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room/category"
+	msg "pixels-emulator/room/message/category"
+	"pixels-emulator/user"
+)
+
+// FlatCategoriesHandler provides the room categories a player can assign rooms to.
+type FlatCategoriesHandler struct {
+	logger    *zap.Logger // logger for packet processing details.
+	db        *gorm.DB    // db is the database to read the categories.
+	userStore user.Store  // userStore is the user store to check user related conn.
+}
+
+// Handle processes the incoming categories packet.
+func (h *FlatCategoriesHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	if _, ok := raw.(*msg.GetFlatCategoriesPacket); !ok {
+		h.logger.Error("cannot cast flat categories packet, skipping processing")
+		return
+	}
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for flat categories", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	res := <-p.Record(ctx)
+	if res.Error != nil {
+		h.logger.Error("error loading player record for flat categories", zap.String("identifier", conn.Identifier()), zap.Error(res.Error))
+		return
+	}
+
+	cats, err := category.All(ctx, h.db)
+	if err != nil {
+		h.logger.Error("error loading flat categories", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	conn.SendPacket(&msg.FlatCategoriesPacket{Categories: category.Encode(cats, *res.Data)})
+
+}
+
+// NewFlatCategories creates a new handler instance.
+func NewFlatCategories() *FlatCategoriesHandler {
+	return &FlatCategoriesHandler{
+		logger:    server.GetServer().Logger(),
+		db:        server.GetServer().Database(),
+		userStore: server.GetServer().UserStore(),
+	}
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"pixels-emulator/core/event"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room"
+	"pixels-emulator/room/encode"
+	roomEvent "pixels-emulator/room/event"
+	"pixels-emulator/room/message/chat"
+	"pixels-emulator/room/message/restriction"
+	"pixels-emulator/user"
+	"strings"
+	"time"
+)
+
+// ChatHandler manages the talk, shout and whisper messages of the players.
+type ChatHandler struct {
+	logger    *zap.Logger   // logger for packet processing details.
+	em        event.Manager // em is the event manager to fire chat events.
+	roomStore room.Store    // roomStore is the room list to check user current room.
+	userStore user.Store    // userStore is the user store to check user related conn.
+}
+
+// Handle processes the incoming chat packets.
+func (h *ChatHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	var t chat.Type
+	var msg, target string
+	var bubble int32
+
+	switch pck := raw.(type) {
+	case *chat.TalkPacket:
+		t, msg, bubble = chat.Talk, pck.Message, pck.Bubble
+	case *chat.ShoutPacket:
+		t, msg, bubble = chat.Shout, pck.Message, pck.Bubble
+	case *chat.WhisperPacket:
+		t, msg, bubble, target = chat.Whisper, pck.Message, pck.Bubble, pck.Target
+	default:
+		h.logger.Error("cannot cast chat packet, skipping processing")
+		return
+	}
+
+	msg = strings.TrimSpace(msg)
+	if msg == "" {
+		return
+	}
+
+	if r := []rune(msg); len(r) > chat.MaxLength {
+		msg = string(r[:chat.MaxLength])
+	}
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for chat", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	r, err := room.GetUserRoom(ctx, h.roomStore, p)
+	if err != nil {
+		h.logger.Error("error retrieving player room for chat", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if r == nil || !r.IsOnline(p) {
+		h.logger.Debug("player is not in-game, skipping chat", zap.String("identifier", conn.Identifier()))
+		return
+	}
+
+	if mute := r.MutedFor(p.Id, time.Now()); mute > 0 {
+		conn.SendPacket(&restriction.RemainingMutePacket{Seconds: int32(mute.Seconds())})
+		return
+	}
+
+	limit := room.FloodLimit(encode.ChatFilter(r.Data().Configuration.ChatProtection))
+	if ok, mute := r.Flood.Allow(p.Id, limit, time.Now()); !ok {
+		conn.SendPacket(&chat.FloodControlPacket{Seconds: int32(mute.Seconds())})
+		return
+	}
+
+	h.em.Fire(roomEvent.RoomChatEventName, roomEvent.NewRoomChatEvent(conn, r.Id, t, msg, bubble, target, 0, make(map[string]string)))
+
+}
+
+// NewChat creates a new handler instance.
+func NewChat() *ChatHandler {
+	return &ChatHandler{
+		logger:    server.GetServer().Logger(),
+		em:        server.GetServer().EventManager(),
+		roomStore: server.GetServer().RoomStore(),
+		userStore: server.GetServer().UserStore(),
+	}
+}
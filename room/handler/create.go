@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/core/config"
+	"pixels-emulator/core/database"
+	"pixels-emulator/core/model"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room"
+	"pixels-emulator/room/category"
+	"pixels-emulator/room/message/creation"
+	userMsg "pixels-emulator/user/message"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	invalidRoomTexts  = "The room name or description is not valid."             // invalidRoomTexts is the alert sent for names or descriptions out of length.
+	invalidRoomLayout = "The selected room layout is not available."             // invalidRoomLayout is the alert sent for unknown or non-essential layouts.
+	roomNotCreated    = "The room could not be created, please try again later." // roomNotCreated is the alert sent when the room could not be persisted.
+)
+
+// RoomCreateHandler manages the players creating new rooms.
+type RoomCreateHandler struct {
+	logger *zap.Logger    // logger for packet processing details.
+	cfg    *config.Config // cfg is the server configuration to check the creation limits.
+	db     *gorm.DB       // db is the database to persist the rooms.
+}
+
+// Handle processes the incoming room creation packets.
+func (h *RoomCreateHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	switch raw.(type) {
+	case *creation.CanCreateRoomPacket, *creation.CreateRoomPacket:
+	default:
+		h.logger.Error("cannot cast room creation packet, skipping processing")
+		return
+	}
+
+	uid, err := strconv.ParseUint(conn.Identifier(), 10, 32)
+	if err != nil {
+		h.logger.Error("invalid connection identifier for room creation", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	owned, err := room.Owned(ctx, h.db, uint(uid))
+	if err != nil {
+		h.logger.Error("error counting player rooms", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	limit := int32(h.cfg.Room.MaxRooms)
+	if owned >= int64(limit) {
+		conn.SendPacket(&creation.CanCreateRoomResultPacket{Result: room.CreationLimitReached, Limit: limit})
+		return
+	}
+
+	pck, create := raw.(*creation.CreateRoomPacket)
+	if !create {
+		conn.SendPacket(&creation.CanCreateRoomResultPacket{Limit: limit})
+		return
+	}
+
+	name, desc := strings.TrimSpace(pck.Name), strings.TrimSpace(pck.Description)
+	if l := utf8.RuneCountInString(name); l < room.NameMinLength || l > room.NameMaxLength || utf8.RuneCountInString(desc) > room.DescriptionMaxLength {
+		h.logger.Debug("invalid room creation texts", zap.String("identifier", conn.Identifier()))
+		conn.SendPacket(&userMsg.AlertPacket{Message: invalidRoomTexts})
+		return
+	}
+
+	hm, err := room.Layout(ctx, h.db, pck.Layout)
+	if err != nil {
+		h.logger.Error("error retrieving room creation layout", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		conn.SendPacket(&userMsg.AlertPacket{Message: roomNotCreated})
+		return
+	}
+
+	if hm == nil {
+		h.logger.Debug("room creation layout not available", zap.String("identifier", conn.Identifier()), zap.String("layout", pck.Layout))
+		conn.SendPacket(&userMsg.AlertPacket{Message: invalidRoomLayout})
+		return
+	}
+
+	uSvc := &database.ModelService[model.User]{DB: h.db}
+	u, err := uSvc.GetSync(ctx, uint(uid))
+	if err != nil {
+		h.logger.Error("error loading user for room creation", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		conn.SendPacket(&userMsg.AlertPacket{Message: roomNotCreated})
+		return
+	}
+
+	cat := uint(max(pck.Category, 0))
+	allowed, err := category.Assignable(ctx, h.db, cat, *u)
+	if err != nil {
+		h.logger.Error("error verifying room creation category", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		conn.SendPacket(&userMsg.AlertPacket{Message: roomNotCreated})
+		return
+	}
+
+	if !allowed {
+		cat = 0
+	}
+
+	r := &model.Room{
+		Name:        name,
+		Description: desc,
+		State:       room.StateOpen,
+		UsersMax:    int(max(1, min(pck.UsersMax, int32(h.cfg.Room.MaxUsers)))),
+		OwnerID:     uint(uid),
+		LayoutId:    hm.ID,
+		CategoryID:  cat,
+		Configuration: model.RoomConfiguration{
+			TradeMode: room.TradeModeOf(pck.TradeMode),
+		},
+	}
+
+	err = room.Create(ctx, h.db, r, int(limit))
+	if errors.Is(err, room.ErrCreationLimit) {
+		conn.SendPacket(&creation.CanCreateRoomResultPacket{Result: room.CreationLimitReached, Limit: limit})
+		return
+	}
+
+	if err != nil {
+		h.logger.Error("error creating room", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		conn.SendPacket(&userMsg.AlertPacket{Message: roomNotCreated})
+		return
+	}
+
+	conn.SendPacket(&creation.RoomCreatedPacket{Room: int32(r.ID), Name: r.Name})
+
+}
+
+// NewRoomCreate creates a new handler instance.
+func NewRoomCreate() *RoomCreateHandler {
+	return &RoomCreateHandler{
+		logger: server.GetServer().Logger(),
+		cfg:    server.GetServer().Config(),
+		db:     server.GetServer().Database(),
+	}
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/core/event"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room"
+	roomEvent "pixels-emulator/room/event"
+	"pixels-emulator/room/message/doorbell"
+	"pixels-emulator/user"
+)
+
+// DoorbellAnswerHandler manages the controllers letting in or denying ringing players.
+type DoorbellAnswerHandler struct {
+	logger    *zap.Logger   // logger for packet processing details.
+	em        event.Manager // em is the event manager to load the room for accepted players.
+	db        *gorm.DB      // db is the database to verify the answering player rights.
+	roomStore room.Store    // roomStore is the room list to check user current room.
+	userStore user.Store    // userStore is the user store to check user related conn.
+}
+
+// Handle processes the incoming doorbell answer packet.
+func (h *DoorbellAnswerHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	pck, ok := raw.(*doorbell.AnswerPacket)
+	if !ok {
+		h.logger.Error("cannot cast doorbell answer packet, skipping processing")
+		return
+	}
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for doorbell answer", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	r, err := room.GetUserRoom(ctx, h.roomStore, p)
+	if err != nil {
+		h.logger.Error("error retrieving player room for doorbell answer", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if r == nil || !r.IsOnline(p) {
+		h.logger.Debug("player is not in-game, skipping doorbell answer", zap.String("identifier", conn.Identifier()))
+		return
+	}
+
+	ring := r.Ringer(pck.Username)
+	if ring == nil {
+		return
+	}
+
+	ok, err = r.IsController(ctx, h.db, p)
+	if err != nil {
+		h.logger.Error("error verifying doorbell answer rights", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if !ok || !r.Answer(ring, pck.Accept) {
+		return
+	}
+
+	cs, err := r.Controllers(ctx, h.db)
+	if err != nil {
+		h.logger.Error("error notifying doorbell answer", zap.String("identifier", conn.Identifier()), zap.Error(err))
+	}
+
+	for _, c := range cs {
+		if pck.Accept {
+			c.Conn().SendPacket(&doorbell.DoorbellAcceptedPacket{Username: pck.Username})
+		} else {
+			c.Conn().SendPacket(&doorbell.DoorbellRejectedPacket{Username: pck.Username})
+		}
+	}
+
+	if pck.Accept {
+		accEv := roomEvent.NewRoomLoadRequestEvent(ring.Player.Conn(), r.Id, 0, make(map[string]string))
+		h.em.Fire(roomEvent.RoomLoadRequestEventName, accEv)
+	}
+
+}
+
+// NewDoorbellAnswer creates a new handler instance.
+func NewDoorbellAnswer() *DoorbellAnswerHandler {
+	return &DoorbellAnswerHandler{
+		logger:    server.GetServer().Logger(),
+		em:        server.GetServer().EventManager(),
+		db:        server.GetServer().Database(),
+		roomStore: server.GetServer().RoomStore(),
+		userStore: server.GetServer().UserStore(),
+	}
+}
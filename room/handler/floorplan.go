@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room"
+	"pixels-emulator/room/message/floorplan"
+	"pixels-emulator/room/path"
+	"pixels-emulator/user"
+	userMsg "pixels-emulator/user/message"
+)
+
+// floorPlanNotSaved is the alert sent when the custom layout could not be persisted.
+const floorPlanNotSaved = "The floor plan could not be saved, please try again later."
+
+// FloorPlanHandler manages the room owners drawing a custom layout with the floor plan editor.
+type FloorPlanHandler struct {
+	logger    *zap.Logger // logger for packet processing details.
+	db        *gorm.DB    // db is the database to persist the custom heightmap.
+	roomStore room.Store  // roomStore is the room list to check user current room.
+	userStore user.Store  // userStore is the user store to check user related conn.
+}
+
+// Handle processes the incoming floor plan editor packets.
+func (h *FloorPlanHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	switch raw.(type) {
+	case *floorplan.GetEntryTilePacket, *floorplan.GetOccupiedTilesPacket, *floorplan.SaveFloorPlanPacket:
+	default:
+		h.logger.Error("cannot cast floor plan packet, skipping processing")
+		return
+	}
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for floor plan", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	r, err := room.GetUserRoom(ctx, h.roomStore, p)
+	if err != nil {
+		h.logger.Error("error retrieving player room for floor plan", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if r == nil || !r.IsOnline(p) {
+		h.logger.Debug("player is not in-game, skipping floor plan", zap.String("identifier", conn.Identifier()))
+		return
+	}
+
+	rel, err := r.Relationship(ctx, h.db, p)
+	if err != nil {
+		h.logger.Error("error verifying floor plan ownership", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if rel != room.Owner {
+		return
+	}
+
+	switch pck := raw.(type) {
+	case *floorplan.GetEntryTilePacket:
+		door := r.Layout().Door()
+		conn.SendPacket(&floorplan.EntryTilePacket{X: int32(door.X()), Y: int32(door.Y()), Direction: int32(door.Dir())})
+	case *floorplan.GetOccupiedTilesPacket:
+		conn.SendPacket(&floorplan.OccupiedTilesPacket{Tiles: r.Occupied()})
+	case *floorplan.SaveFloorPlanPacket:
+		hMap := r.FloorPlan(pck)
+		l, err := path.ValidateHeightMap(hMap)
+		if err != nil {
+			conn.SendPacket(&userMsg.AlertPacket{Message: err.Error()})
+			return
+		}
+
+		err = r.SaveFloorPlan(ctx, h.db, hMap, l, pck)
+		if errors.Is(err, room.ErrFloorPlanItems) {
+			conn.SendPacket(&userMsg.AlertPacket{Message: err.Error()})
+			return
+		}
+
+		if err != nil {
+			h.logger.Error("error saving floor plan", zap.String("identifier", conn.Identifier()), zap.Error(err))
+			conn.SendPacket(&userMsg.AlertPacket{Message: floorPlanNotSaved})
+		}
+	}
+
+}
+
+// NewFloorPlan creates a new handler instance.
+func NewFloorPlan() *FloorPlanHandler {
+	return &FloorPlanHandler{
+		logger:    server.GetServer().Logger(),
+		db:        server.GetServer().Database(),
+		roomStore: server.GetServer().RoomStore(),
+		userStore: server.GetServer().UserStore(),
+	}
+}
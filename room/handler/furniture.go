@@ -15,7 +15,8 @@ import (
 	"pixels-emulator/user"
 )
 
-// FurnitureRequestHandler INVESTIGATION: Why does this exist?
+// FurnitureRequestHandler sends the heightmaps, the items and the room data once
+// Nitro requests the furniture aliases while entering a room.
 type FurnitureRequestHandler struct {
 	logger    *zap.Logger   // logger for packet processing details.
 	roomStore room.Store    // roomStore is the room list to check user transitioning room.
@@ -68,13 +69,15 @@ func (h *FurnitureRequestHandler) Handle(ctx context.Context, raw protocol.Packe
 		return
 	}
 
-	room.SendHeightMapPackets(conn, int32(r.Data.Configuration.WallHeight), r.Layout())
+	r.SendHeightMap(conn)
+	r.SendItems(conn)
 	conn.SendPacket(&message.OpenRoomConnectionPacket{})
 
+	d := r.Data()
 	upPck := &guest.ResponseRoomPacket{
 		Enter:         true,
 		Forward:       false,
-		Room:          room.EncodeRoom(&r.Data, r),
+		Room:          room.EncodeRoom(&d, r),
 		StaffPick:     false, // TODO: Make this work, create full response packet on utility
 		GuildMember:   false,
 		GlobalMute:    false,
@@ -84,14 +87,14 @@ func (h *FurnitureRequestHandler) Handle(ctx context.Context, raw protocol.Packe
 			Kick: encode.Administrator,
 			Ban:  encode.Administrator,
 		},
-		Settings: room.EncodeSettings(&r.Data.Configuration),
+		Settings: room.EncodeSettings(&d.Configuration),
 	}
 	conn.SendPacket(upPck)
 
 	vis := &misc.RoomVisualizationSettingsPacket{
-		FloorSize: int32(r.Data.Configuration.FloorThickness),
-		WallSize:  int32(r.Data.Configuration.WallThickness),
-		HideWall:  r.Data.Configuration.AllowHideWall,
+		FloorSize: int32(d.Configuration.FloorThickness),
+		WallSize:  int32(d.Configuration.WallThickness),
+		HideWall:  d.Configuration.AllowHideWall,
 	}
 	conn.SendPacket(vis)
 
@@ -3,23 +3,31 @@ package handler
 import (
 	"context"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/core/database"
+	"pixels-emulator/core/model"
 	"pixels-emulator/core/protocol"
 	"pixels-emulator/core/server"
-	"pixels-emulator/room/encode"
+	"pixels-emulator/room"
 	"pixels-emulator/room/message/guest"
+	"pixels-emulator/user"
+	"strconv"
 )
 
 // GetGuestRoomHandler handles non-owned room join attempt.
 type GetGuestRoomHandler struct {
-	logger *zap.Logger // Logger for packet processing details.
+	logger    *zap.Logger // Logger for packet processing details.
+	db        *gorm.DB    // db is the database to load the room and the user relationship.
+	roomStore room.Store  // roomStore is the room list to prefer the live room data.
+	userStore user.Store  // userStore is the user store to check user related conn.
 }
 
-// Handle processes the incoming navigation search packet.
-func (h *GetGuestRoomHandler) Handle(_ context.Context, raw protocol.Packet, conn protocol.Connection) {
+// Handle processes the incoming guest room packet.
+func (h *GetGuestRoomHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
 
 	pck, ok := raw.(*guest.GetRoomPacket)
 	if !ok {
-		h.logger.Error("cannot cast navigator search packet, skipping processing")
+		h.logger.Error("cannot cast guest room packet, skipping processing")
 		return
 	}
 
@@ -32,58 +40,61 @@ func (h *GetGuestRoomHandler) Handle(_ context.Context, raw protocol.Packet, con
 	// Therefore, we decided to create cancellable events deeper in the
 	// room logic (Like room entering).
 
-	testPck := &guest.ResponseRoomPacket{
-		Enter:   pck.Enter,
-		Forward: pck.Forward,
-		Room: &encode.RoomData{
-			ID:                1,
-			Name:              "Test Room",
-			OwnerID:           100,
-			OwnerName:         "Owner",
-			IsPublic:          false,
-			DoorMode:          encode.Door(1),
-			UserCount:         10,
-			UserMax:           50,
-			Description:       "A test room",
-			Score:             200,
-			Category:          3,
-			Tags:              []string{"fun", "game"},
-			GuildID:           2,
-			GuildName:         "Guild Name",
-			GuildBadge:        "Badge123",
-			PromotionTitle:    "Special Promo",
-			PromotionDesc:     "Limited time event",
-			PromotionTime:     120,
-			Thumbnail:         "thumbnail.png",
-			AllowPets:         true,
-			FeaturedPromotion: true,
-		},
-		StaffPick:     true,
-		GuildMember:   true,
-		GlobalMute:    true,
-		CanGlobalMute: false,
-		Moderation: &encode.ModerationRights{
-			Mute: encode.None,
-			Kick: encode.None,
-			Ban:  encode.None,
-		},
-		Settings: &encode.RoomChatSettings{
-			Mode:       encode.ChatModeFreeFlow,
-			Weight:     encode.ChatBubbleWidthNormal,
-			Speed:      encode.ChatScrollSpeedNormal,
-			Distance:   10,
-			Protection: encode.FloodFilterNormal,
-		},
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for guest room", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	uRes := <-p.Record(ctx)
+	if uRes.Error != nil {
+		h.logger.Error("error loading player record for guest room", zap.String("identifier", conn.Identifier()), zap.Error(uRes.Error))
+		return
+	}
+
+	// Loaded rooms are preferred, as their data may not be persisted yet.
+	var data *model.Room
+	live, lErr := h.roomStore.Records().Read(ctx, strconv.Itoa(int(pck.RoomId)))
+	if lErr == nil && live != nil {
+		d := live.Data()
+		data = &d
+	} else {
+		live = nil
+		rSvc := &database.ModelService[model.Room]{DB: h.db}
+		data, err = rSvc.GetSync(ctx, uint(pck.RoomId))
+		if err != nil {
+			h.logger.Debug("guest room not found", zap.Int32("room", pck.RoomId), zap.Error(err))
+			return
+		}
+	}
+
+	rel, err := room.VerifyUserRoomRelationship(ctx, h.db, *data, *uRes.Data)
+	if err != nil {
+		h.logger.Error("error verifying guest room relationship", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
 	}
-	// TODO: Replace with real data.
 
-	conn.SendPacket(testPck)
+	// Banned users are only provided the information, without entering.
+	conn.SendPacket(&guest.ResponseRoomPacket{
+		Enter:         pck.Enter && rel != room.Restriction,
+		Forward:       pck.Forward,
+		Room:          room.EncodeRoom(data, live),
+		StaffPick:     false,
+		GuildMember:   false,
+		GlobalMute:    false,
+		CanGlobalMute: rel == room.Owner || rel == room.Rights,
+		Moderation:    room.Moderation(rel),
+		Settings:      room.EncodeSettings(&data.Configuration),
+	})
 
 }
 
-// NewNavigatorSearch creates a new handler instance.
-func NewNavigatorSearch() *GetGuestRoomHandler {
+// NewGetGuestRoom creates a new handler instance.
+func NewGetGuestRoom() *GetGuestRoomHandler {
 	return &GetGuestRoomHandler{
-		logger: server.GetServer().Logger(),
+		logger:    server.GetServer().Logger(),
+		db:        server.GetServer().Database(),
+		roomStore: server.GetServer().RoomStore(),
+		userStore: server.GetServer().UserStore(),
 	}
 }
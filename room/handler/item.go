@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/core/config"
+	"pixels-emulator/core/model"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/inventory"
+	"pixels-emulator/room"
+	"pixels-emulator/room/message/item"
+	"pixels-emulator/user"
+	"strconv"
+)
+
+// RoomItemHandler manages the controllers placing, moving and picking up the room items.
+// Items are placed from the inventory of the player and picked up to the inventory of their owner.
+type RoomItemHandler struct {
+	logger    *zap.Logger    // logger for packet processing details.
+	db        *gorm.DB       // db is the database to persist the items.
+	roomStore room.Store     // roomStore is the room list to check user current room.
+	userStore user.Store     // userStore is the user store to check user related conn.
+	cfg       *config.Config // cfg is the configuration to check the inventories capacity.
+}
+
+// Handle processes the incoming item placement packets.
+func (h *RoomItemHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for item placement", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	r, err := room.GetUserRoom(ctx, h.roomStore, p)
+	if err != nil {
+		h.logger.Error("error retrieving player room for item placement", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if r == nil || !r.IsOnline(p) {
+		h.logger.Debug("player is not in-game, skipping item placement", zap.String("identifier", conn.Identifier()))
+		return
+	}
+
+	rel, err := r.Relationship(ctx, h.db, p)
+	if err != nil {
+		h.logger.Error("error verifying player relationship for item placement", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if rel != room.Owner && rel != room.Rights {
+		h.logger.Debug("player without rights placing items", zap.String("identifier", conn.Identifier()))
+		return
+	}
+
+	uid, err := strconv.Atoi(p.Id)
+	if err != nil {
+		return
+	}
+
+	switch pck := raw.(type) {
+	case *item.PlaceItemPacket:
+		err = h.place(ctx, r, p, pck)
+	case *item.MoveFloorItemPacket:
+		err = h.moveFloor(ctx, r, conn, pck)
+	case *item.MoveWallItemPacket:
+		var i *model.RoomItem
+		if i, err = r.Item(uint(pck.Item)); err == nil {
+			err = r.MoveWallItem(ctx, h.db, i, pck.Position)
+		}
+	case *item.PickupItemPacket:
+		var i *model.RoomItem
+		if i, err = r.Item(uint(pck.Item)); err == nil {
+			// Rights holders only pick up their own items, owners pick up any.
+			if rel != room.Owner && i.UserID != uint(uid) {
+				return
+			}
+			err = h.pickup(ctx, r, i, uint(uid))
+		}
+	default:
+		h.logger.Error("cannot cast item placement packet, skipping processing")
+		return
+	}
+
+	if err != nil {
+		h.logger.Debug("item placement rejected", zap.String("identifier", conn.Identifier()), zap.Error(err))
+	}
+
+}
+
+// place places an item of the player inventory in the room.
+func (h *RoomItemHandler) place(ctx context.Context, r *room.Room, p *user.Player, pck *item.PlaceItemPacket) error {
+
+	inv, err := p.Inventory(ctx, h.db, int(h.cfg.Inventory.MaxItems))
+	if err != nil {
+		return err
+	}
+
+	from, err := inv.Item(uint(pck.Item))
+	if err != nil {
+		return err
+	}
+
+	if from.Definition.Type == model.WallItem {
+		if !pck.Wall {
+			return room.ErrPlacementWall
+		}
+		_, err = r.PlaceWallItem(ctx, h.db, from, pck.Position)
+	} else {
+		if pck.Wall {
+			return room.ErrPlacementTile
+		}
+		_, err = r.PlaceFloorItem(ctx, h.db, from, int(pck.X), int(pck.Y), int(pck.Rotation))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = inv.Pull(p.Conn(), from.ID)
+	return err
+
+}
+
+// pickup returns an item to the inventory of its owner, if there is room left on it.
+// The owner is only notified when online and with the inventory already loaded.
+func (h *RoomItemHandler) pickup(ctx context.Context, r *room.Room, i *model.RoomItem, picker uint) error {
+
+	var inv *inventory.Inventory
+	owner, err := h.userStore.Records().Read(ctx, strconv.Itoa(int(i.UserID)))
+	if err == nil && owner != nil {
+		inv = owner.LoadedInventory()
+	}
+
+	if err := inventory.Fits(ctx, h.db, inv, i.UserID, int(h.cfg.Inventory.MaxItems), 1); err != nil {
+		return err
+	}
+
+	kept, err := r.Pickup(ctx, h.db, i, picker)
+	if err != nil {
+		return err
+	}
+
+	if inv == nil {
+		return nil
+	}
+	return inv.Push(owner.Conn(), kept)
+
+}
+
+// moveFloor moves a floor item, restoring it to the player if rejected.
+func (h *RoomItemHandler) moveFloor(ctx context.Context, r *room.Room, conn protocol.Connection, pck *item.MoveFloorItemPacket) error {
+
+	i, err := r.Item(uint(pck.Item))
+	if err != nil {
+		return err
+	}
+
+	if err = r.MoveFloorItem(ctx, h.db, i, int(pck.X), int(pck.Y), int(pck.Rotation)); err != nil {
+		conn.SendPacket(&item.FloorItemUpdatePacket{Item: room.EncodeFloorItem(i)})
+	}
+	return err
+
+}
+
+// NewRoomItem creates a new handler instance.
+func NewRoomItem() *RoomItemHandler {
+	return &RoomItemHandler{
+		logger:    server.GetServer().Logger(),
+		db:        server.GetServer().Database(),
+		roomStore: server.GetServer().RoomStore(),
+		userStore: server.GetServer().UserStore(),
+		cfg:       server.GetServer().Config(),
+	}
+}
@@ -0,0 +1,206 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/catalog"
+	catalogMsg "pixels-emulator/catalog/message"
+	"pixels-emulator/core/model"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room"
+	"pixels-emulator/room/message/promotion"
+	"pixels-emulator/user"
+	userMsg "pixels-emulator/user/message"
+	"strconv"
+)
+
+// RoomPromotionHandler manages the owners buying and editing the promotions of their rooms.
+// Promotions are bought with the offers of the room ads catalog pages.
+type RoomPromotionHandler struct {
+	logger    *zap.Logger // logger for packet processing details.
+	db        *gorm.DB    // db is the database to persist the promotions.
+	roomStore room.Store  // roomStore is the room list to notify the loaded rooms.
+	userStore user.Store  // userStore is the user store to charge the promotions.
+}
+
+// Handle processes the incoming room promotion packets.
+func (h *RoomPromotionHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	uid, err := strconv.Atoi(conn.Identifier())
+	if err != nil {
+		h.logger.Error("invalid room promotion user identifier", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	var (
+		p    *model.RoomPromotion
+		live *room.Room
+	)
+
+	switch pck := raw.(type) {
+	case *promotion.PurchaseRoomAdPacket:
+
+		title, desc := pck.Title, pck.Description
+		if !room.ValidPromotion(&title, &desc) {
+			h.logger.Debug("invalid room promotion texts", zap.String("identifier", conn.Identifier()))
+			return
+		}
+
+		var owner uint
+		live, owner, err = h.owner(ctx, uint(pck.Room))
+		if err != nil || owner != uint(uid) {
+			h.logger.Debug("room promotion bought by non owner", zap.String("identifier", conn.Identifier()), zap.Error(err))
+			return
+		}
+
+		u, offer, ok := h.offer(ctx, conn, pck)
+		if !ok {
+			return
+		}
+
+		err = h.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := catalog.Charge(tx, u, offer); err != nil {
+				return err
+			}
+			var pErr error
+			p, pErr = room.Promote(ctx, tx, uint(pck.Room), title, desc, int(pck.Category), pck.Extended)
+			return pErr
+		})
+
+		if errors.Is(err, catalog.ErrNotEnoughBalance) {
+			conn.SendPacket(&catalogMsg.NotEnoughBalancePacket{Credits: offer.CostCredits > 0, Points: offer.CostPoints > 0, PointsType: int32(offer.PointsType)})
+			return
+		}
+		if err == nil {
+			conn.SendPacket(&catalogMsg.PurchaseOKPacket{Offer: catalog.EncodeOffer(offer)})
+			h.balance(ctx, conn)
+		}
+
+	case *promotion.EditRoomEventPacket:
+
+		title, desc := pck.Title, pck.Description
+		if !room.ValidPromotion(&title, &desc) {
+			h.logger.Debug("invalid room promotion texts", zap.String("identifier", conn.Identifier()))
+			return
+		}
+
+		p, err = room.ActivePromotion(ctx, h.db, uint(pck.Event))
+		if err != nil || p == nil {
+			h.logger.Debug("room promotion not found", zap.Int32("promotion", pck.Event), zap.Error(err))
+			return
+		}
+
+		var owner uint
+		live, owner, err = h.owner(ctx, p.RoomID)
+		if err != nil || owner != uint(uid) {
+			h.logger.Debug("room promotion edited by non owner", zap.String("identifier", conn.Identifier()), zap.Error(err))
+			return
+		}
+
+		err = room.EditPromotion(ctx, h.db, p, title, desc)
+
+	default:
+		h.logger.Error("cannot cast room promotion packet, skipping processing")
+		return
+	}
+
+	if err != nil {
+		h.logger.Error("error saving room promotion", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		if _, bought := raw.(*promotion.PurchaseRoomAdPacket); bought {
+			conn.SendPacket(&catalogMsg.PurchaseErrorPacket{Code: catalogMsg.ServerError})
+		}
+		return
+	}
+
+	if live != nil {
+		live.SetPromotion(p)
+	}
+
+}
+
+// owner provides the owner of a room, along the room if loaded.
+func (h *RoomPromotionHandler) owner(ctx context.Context, id uint) (*room.Room, uint, error) {
+
+	if live, err := h.roomStore.Records().Read(ctx, strconv.Itoa(int(id))); err == nil && live != nil {
+		return live, live.Data().OwnerID, nil
+	}
+
+	var owner uint
+	err := h.db.WithContext(ctx).Model(&model.Room{}).Select("owner_id").Where("id = ?", id).Scan(&owner).Error
+	return nil, owner, err
+
+}
+
+// offer provides the room ads offer bought along the buyer record, rejecting
+// the offers not sold on a room ads page or not affordable by the buyer.
+func (h *RoomPromotionHandler) offer(ctx context.Context, conn protocol.Connection, pck *promotion.PurchaseRoomAdPacket) (model.User, *model.CatalogOffer, bool) {
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for room promotion", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return model.User{}, nil, false
+	}
+
+	res := <-p.Record(ctx)
+	if res.Error != nil {
+		h.logger.Error("error loading player record for room promotion", zap.String("identifier", conn.Identifier()), zap.Error(res.Error))
+		return model.User{}, nil, false
+	}
+	u := *res.Data
+
+	page, err := catalog.Page(ctx, h.db, uint(pck.Page), u)
+	if err == nil && page.Layout != catalog.RoomAdsLayout {
+		err = catalog.ErrPageNotFound
+	}
+
+	var offer *model.CatalogOffer
+	if err == nil {
+		offer, err = catalog.Offer(page, uint(pck.Offer))
+	}
+
+	if err != nil {
+		h.logger.Debug("room promotion offer not allowed", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		conn.SendPacket(&catalogMsg.PurchaseNotAllowedPacket{Code: catalogMsg.IllegalPurchase})
+		return u, nil, false
+	}
+
+	if credits, points := catalog.Affordable(u, offer, 1); !credits || !points {
+		conn.SendPacket(&catalogMsg.NotEnoughBalancePacket{Credits: !credits, Points: !points, PointsType: int32(offer.PointsType)})
+		return u, nil, false
+	}
+
+	return u, offer, true
+
+}
+
+// balance sends the credits and activity points of the player after buying a promotion.
+func (h *RoomPromotionHandler) balance(ctx context.Context, conn protocol.Connection) {
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		return
+	}
+
+	res := <-p.Record(ctx)
+	if res.Error != nil {
+		h.logger.Error("error loading player balance after room promotion", zap.String("identifier", conn.Identifier()), zap.Error(res.Error))
+		return
+	}
+
+	conn.SendPacket(&userMsg.CreditsPacket{Credits: int32(res.Data.Credits)})
+	conn.SendPacket(&userMsg.CurrencyPacket{Currencies: catalog.Currencies(*res.Data)})
+
+}
+
+// NewRoomPromotion creates a new handler instance.
+func NewRoomPromotion() *RoomPromotionHandler {
+	return &RoomPromotionHandler{
+		logger:    server.GetServer().Logger(),
+		db:        server.GetServer().Database(),
+		roomStore: server.GetServer().RoomStore(),
+		userStore: server.GetServer().UserStore(),
+	}
+}
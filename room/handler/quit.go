@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room"
+	"pixels-emulator/room/message"
+	"pixels-emulator/user"
+)
+
+// RoomQuitHandler manages the players returning to the hotel view.
+type RoomQuitHandler struct {
+	logger    *zap.Logger // logger for packet processing details.
+	roomStore room.Store  // roomStore is the room list to check user current room.
+	userStore user.Store  // userStore is the user store to check user related conn.
+}
+
+// Handle processes the incoming quit packet.
+func (h *RoomQuitHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	_, ok := raw.(*message.RoomQuitPacket)
+	if !ok {
+		h.logger.Error("cannot cast room quit packet, skipping processing")
+		return
+	}
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for leaving", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	r, err := room.GetUserRoom(ctx, h.roomStore, p)
+	if err != nil {
+		h.logger.Error("error retrieving player room for leaving", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if r == nil {
+		return
+	}
+
+	r.Leave(p)
+
+}
+
+// NewRoomQuit creates a new handler instance.
+func NewRoomQuit() *RoomQuitHandler {
+	return &RoomQuitHandler{
+		logger:    server.GetServer().Logger(),
+		roomStore: server.GetServer().RoomStore(),
+		userStore: server.GetServer().UserStore(),
+	}
+}
@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/core/database"
+	"pixels-emulator/core/event"
+	"pixels-emulator/core/model"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room"
+	"pixels-emulator/room/message"
+	msg "pixels-emulator/room/message/restriction"
+	"pixels-emulator/room/restriction"
+	"pixels-emulator/user"
+	userMsg "pixels-emulator/user/message"
+	"strconv"
+)
+
+// RoomRestrictionHandler manages the room controllers banning, muting and kicking users.
+type RoomRestrictionHandler struct {
+	logger    *zap.Logger   // logger for packet processing details.
+	em        event.Manager // em is the event manager to close the restricted users connection.
+	db        *gorm.DB      // db is the database to persist the restrictions.
+	roomStore room.Store    // roomStore is the room list to check user current room.
+	userStore user.Store    // userStore is the user store to check user related conn.
+}
+
+// Handle processes the incoming restriction packets.
+func (h *RoomRestrictionHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	var target int32
+	switch pck := raw.(type) {
+	case *msg.BanPacket:
+		target = pck.User
+	case *msg.UnbanPacket:
+		target = pck.User
+	case *msg.MutePacket:
+		target = pck.User
+	case *msg.KickPacket:
+		target = pck.User
+	case *msg.BanListRequestPacket:
+	default:
+		h.logger.Error("cannot cast restriction packet, skipping processing")
+		return
+	}
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for restriction", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	r, err := room.GetUserRoom(ctx, h.roomStore, p)
+	if err != nil {
+		h.logger.Error("error retrieving player room for restriction", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if r == nil || !r.IsOnline(p) {
+		h.logger.Debug("player is not in-game, skipping restriction", zap.String("identifier", conn.Identifier()))
+		return
+	}
+
+	ok, err := r.IsController(ctx, h.db, p)
+	if err != nil {
+		h.logger.Error("error verifying restriction rights", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if !ok {
+		return
+	}
+
+	if pck, list := raw.(*msg.BanListRequestPacket); list {
+		h.list(ctx, r, conn, pck)
+		return
+	}
+
+	id := strconv.Itoa(int(target))
+	if id == p.Id {
+		return
+	}
+
+	protected, err := h.protected(ctx, r, uint(target))
+	if err != nil {
+		h.logger.Error("error verifying restriction target", zap.String("identifier", conn.Identifier()), zap.String("target", id), zap.Error(err))
+		return
+	}
+
+	if protected {
+		return
+	}
+
+	t, _ := r.Player(id)
+
+	switch pck := raw.(type) {
+	case *msg.BanPacket:
+		err = restriction.Ban(ctx, h.db, r.Id, uint(target), restriction.BanDuration(pck.Type))
+		if err == nil && t != nil {
+			room.CloseConnection(t.Conn(), message.Banned, "", h.em)
+		}
+	case *msg.UnbanPacket:
+		err = restriction.Unban(ctx, h.db, r.Id, uint(target))
+		if err == nil {
+			conn.SendPacket(&msg.UnbannedPacket{Room: int32(r.Id), User: target})
+		}
+	case *msg.MutePacket:
+		d := restriction.MuteDuration(pck.Minutes)
+		until, mErr := restriction.Mute(ctx, h.db, r.Id, uint(target), d)
+		err = mErr
+		if err == nil && t != nil {
+			r.Mute(id, until)
+			t.Conn().SendPacket(&msg.RemainingMutePacket{Seconds: int32(d.Seconds())})
+		}
+	case *msg.KickPacket:
+		if t != nil {
+			t.Conn().SendPacket(&userMsg.GenericErrorPacket{Code: userMsg.KickedCode})
+			room.CloseConnection(t.Conn(), message.Default, "", h.em)
+		}
+	}
+
+	if err != nil {
+		h.logger.Error("error applying room restriction", zap.String("identifier", conn.Identifier()), zap.String("target", id), zap.Error(err))
+	}
+
+}
+
+// list sends the banned users of the room.
+func (h *RoomRestrictionHandler) list(ctx context.Context, r *room.Room, conn protocol.Connection, _ *msg.BanListRequestPacket) {
+
+	bans, err := restriction.Bans(ctx, h.db, r.Id)
+	if err != nil {
+		h.logger.Error("error retrieving room bans", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	users := make([]msg.BannedUser, 0, len(bans))
+	for _, b := range bans {
+		users = append(users, msg.BannedUser{Id: int32(b.UserID), Username: b.User.Username})
+	}
+
+	conn.SendPacket(&msg.BanListPacket{Room: int32(r.Id), Users: users})
+
+}
+
+// protected checks if the target user is the room owner, a rights holder or staff, which can not be restricted.
+func (h *RoomRestrictionHandler) protected(ctx context.Context, r *room.Room, target uint) (bool, error) {
+
+	uSvc := &database.ModelService[model.User]{DB: h.db}
+	res := <-uSvc.Get(ctx, target)
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	rel, err := room.VerifyUserRoomRelationship(ctx, h.db, r.Data(), *res.Data)
+	if err != nil {
+		return false, err
+	}
+
+	return rel == room.Owner || rel == room.Rights || rel == room.Access, nil
+
+}
+
+// NewRoomRestriction creates a new handler instance.
+func NewRoomRestriction() *RoomRestrictionHandler {
+	return &RoomRestrictionHandler{
+		logger:    server.GetServer().Logger(),
+		em:        server.GetServer().EventManager(),
+		db:        server.GetServer().Database(),
+		roomStore: server.GetServer().RoomStore(),
+		userStore: server.GetServer().UserStore(),
+	}
+}
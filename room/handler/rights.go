@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/core/database"
+	"pixels-emulator/core/event"
+	"pixels-emulator/core/model"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room"
+	roomEvent "pixels-emulator/room/event"
+	msg "pixels-emulator/room/message/rights"
+	"pixels-emulator/room/rights"
+	"pixels-emulator/user"
+	"strconv"
+)
+
+// RoomRightsHandler manages the room owners giving, removing and listing rights.
+type RoomRightsHandler struct {
+	logger    *zap.Logger   // logger for packet processing details.
+	em        event.Manager // em is the event manager to notify rights changes.
+	db        *gorm.DB      // db is the database to persist the rights.
+	roomStore room.Store    // roomStore is the room list to check user current room.
+	userStore user.Store    // userStore is the user store to check user related conn.
+}
+
+// Handle processes the incoming rights packets.
+func (h *RoomRightsHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	switch raw.(type) {
+	case *msg.GiveRightsPacket, *msg.RemoveRightsPacket, *msg.RemoveAllRightsPacket, *msg.RightsListRequestPacket:
+	default:
+		h.logger.Error("cannot cast rights packet, skipping processing")
+		return
+	}
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for rights", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	r, err := room.GetUserRoom(ctx, h.roomStore, p)
+	if err != nil {
+		h.logger.Error("error retrieving player room for rights", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if r == nil || !r.IsOnline(p) {
+		h.logger.Debug("player is not in-game, skipping rights", zap.String("identifier", conn.Identifier()))
+		return
+	}
+
+	rel, err := r.Relationship(ctx, h.db, p)
+	if err != nil {
+		h.logger.Error("error verifying rights ownership", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if rel != room.Owner {
+		return
+	}
+
+	switch pck := raw.(type) {
+	case *msg.GiveRightsPacket:
+		err = h.give(ctx, r, conn, uint(pck.User))
+	case *msg.RemoveRightsPacket:
+		users := make([]uint, 0, len(pck.Users))
+		for _, u := range pck.Users {
+			users = append(users, uint(u))
+		}
+		err = h.remove(ctx, r, conn, users)
+	case *msg.RemoveAllRightsPacket:
+		err = h.removeAll(ctx, r, conn)
+	case *msg.RightsListRequestPacket:
+		err = h.list(ctx, r, conn)
+	}
+
+	if err != nil {
+		h.logger.Error("error managing room rights", zap.String("identifier", conn.Identifier()), zap.Error(err))
+	}
+
+}
+
+// give grants rights to a user, updating its flat control if in-game.
+func (h *RoomRightsHandler) give(ctx context.Context, r *room.Room, conn protocol.Connection, target uint) error {
+
+	uSvc := &database.ModelService[model.User]{DB: h.db}
+	res := <-uSvc.Get(ctx, target)
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.Data.ID == r.Data().OwnerID {
+		return nil
+	}
+
+	created, err := rights.Grant(ctx, h.db, r.Id, target)
+	if err != nil || !created {
+		return err
+	}
+
+	if t, ok := r.Player(strconv.Itoa(int(target))); ok {
+		r.Control(t, room.RightsControl)
+	}
+
+	conn.SendPacket(&msg.ControllerAddedPacket{Room: int32(r.Id), User: int32(target), Username: res.Data.Username})
+	h.em.Fire(roomEvent.RoomRightsChangedEventName, roomEvent.NewRoomRightsChangedEvent(r.Id, target, true, 0, make(map[string]string)))
+	return nil
+
+}
+
+// remove revokes the rights of the users, updating their flat control if in-game.
+// Only the users who had rights are notified.
+func (h *RoomRightsHandler) remove(ctx context.Context, r *room.Room, conn protocol.Connection, users []uint) error {
+
+	revoked, err := rights.Revoke(ctx, h.db, r.Id, users...)
+	if err != nil {
+		return err
+	}
+
+	for _, u := range revoked {
+
+		if t, ok := r.Player(strconv.Itoa(int(u))); ok {
+			r.Control(t, room.NoControl)
+		}
+
+		conn.SendPacket(&msg.ControllerRemovedPacket{Room: int32(r.Id), User: int32(u)})
+		h.em.Fire(roomEvent.RoomRightsChangedEventName, roomEvent.NewRoomRightsChangedEvent(r.Id, u, false, 0, make(map[string]string)))
+
+	}
+
+	return nil
+
+}
+
+// removeAll revokes the rights of every user of the room.
+func (h *RoomRightsHandler) removeAll(ctx context.Context, r *room.Room, conn protocol.Connection) error {
+
+	perms, err := rights.Holders(ctx, h.db, r.Id)
+	if err != nil {
+		return err
+	}
+
+	users := make([]uint, 0, len(perms))
+	for _, perm := range perms {
+		users = append(users, perm.UserID)
+	}
+
+	return h.remove(ctx, r, conn, users)
+
+}
+
+// list sends the users with rights of the room.
+func (h *RoomRightsHandler) list(ctx context.Context, r *room.Room, conn protocol.Connection) error {
+
+	perms, err := rights.Holders(ctx, h.db, r.Id)
+	if err != nil {
+		return err
+	}
+
+	users := make([]msg.Controller, 0, len(perms))
+	for _, perm := range perms {
+		users = append(users, msg.Controller{Id: int32(perm.UserID), Username: perm.User.Username})
+	}
+
+	conn.SendPacket(&msg.ControllersPacket{Room: int32(r.Id), Users: users})
+	return nil
+
+}
+
+// NewRoomRights creates a new handler instance.
+func NewRoomRights() *RoomRightsHandler {
+	return &RoomRightsHandler{
+		logger:    server.GetServer().Logger(),
+		em:        server.GetServer().EventManager(),
+		db:        server.GetServer().Database(),
+		roomStore: server.GetServer().RoomStore(),
+		userStore: server.GetServer().UserStore(),
+	}
+}
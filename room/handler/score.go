@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room"
+	"pixels-emulator/room/message/score"
+	"pixels-emulator/user"
+	"strconv"
+)
+
+// RoomRateHandler manages the players liking the room.
+type RoomRateHandler struct {
+	logger    *zap.Logger // logger for packet processing details.
+	db        *gorm.DB    // db is the database to persist the votes.
+	roomStore room.Store  // roomStore is the room list to check user current room.
+	userStore user.Store  // userStore is the user store to check user related conn.
+}
+
+// Handle processes the incoming rate packet.
+func (h *RoomRateHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	pck, ok := raw.(*score.RatePacket)
+	if !ok {
+		h.logger.Error("cannot cast rate packet, skipping processing")
+		return
+	}
+
+	// Nitro only allows likes.
+	if pck.Points != 1 {
+		return
+	}
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for room rating", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	r, err := room.GetUserRoom(ctx, h.roomStore, p)
+	if err != nil {
+		h.logger.Error("error retrieving player room for room rating", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if r == nil || !r.IsOnline(p) {
+		h.logger.Debug("player is not in-game, skipping room rating", zap.String("identifier", conn.Identifier()))
+		return
+	}
+
+	uid, err := strconv.Atoi(p.Id)
+	if err != nil || r.Data().OwnerID == uint(uid) {
+		return
+	}
+
+	voted, err := r.Vote(ctx, h.db, uint(uid))
+	if err != nil {
+		h.logger.Error("error voting room", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if !voted {
+		return
+	}
+
+	if err := r.BroadcastScore(ctx, h.db, uint(uid)); err != nil {
+		h.logger.Error("error sending room score", zap.String("identifier", conn.Identifier()), zap.Error(err))
+	}
+
+}
+
+// NewRoomRate creates a new handler instance.
+func NewRoomRate() *RoomRateHandler {
+	return &RoomRateHandler{
+		logger:    server.GetServer().Logger(),
+		db:        server.GetServer().Database(),
+		roomStore: server.GetServer().RoomStore(),
+		userStore: server.GetServer().UserStore(),
+	}
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"pixels-emulator/core/config"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room"
+	"pixels-emulator/room/category"
+	"pixels-emulator/room/message/misc"
+	"pixels-emulator/room/message/settings"
+	"pixels-emulator/user"
+	userMsg "pixels-emulator/user/message"
+)
+
+// settingsNotSaved is the alert sent when the room settings could not be persisted.
+const settingsNotSaved = "The room settings could not be saved, please try again later."
+
+// RoomSettingsHandler manages the room owners reading and saving the room settings.
+type RoomSettingsHandler struct {
+	logger    *zap.Logger    // logger for packet processing details.
+	cfg       *config.Config // cfg is the server configuration to check the settings limits.
+	db        *gorm.DB       // db is the database to persist the settings.
+	roomStore room.Store     // roomStore is the room list to check user current room.
+	userStore user.Store     // userStore is the user store to check user related conn.
+}
+
+// Handle processes the incoming settings packets.
+func (h *RoomSettingsHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	var id int32
+	switch pck := raw.(type) {
+	case *settings.GetSettingsPacket:
+		id = pck.Room
+	case *settings.SaveSettingsPacket:
+		id = pck.Room
+	default:
+		h.logger.Error("cannot cast room settings packet, skipping processing")
+		return
+	}
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for room settings", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	r, err := room.GetUserRoom(ctx, h.roomStore, p)
+	if err != nil {
+		h.logger.Error("error retrieving player room for room settings", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if r == nil || !r.IsOnline(p) || int32(r.Id) != id {
+		h.logger.Debug("player is not in-game of the room, skipping settings", zap.String("identifier", conn.Identifier()))
+		return
+	}
+
+	rel, err := r.Relationship(ctx, h.db, p)
+	if err != nil {
+		h.logger.Error("error verifying room settings ownership", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if rel != room.Owner {
+		return
+	}
+
+	limit := int(h.cfg.Room.MaxUsers)
+	pck, save := raw.(*settings.SaveSettingsPacket)
+	if !save {
+		conn.SendPacket(&settings.SettingsPacket{Settings: r.Settings(), UsersLimit: int32(limit)})
+		return
+	}
+
+	if code, ok := r.ValidateSettings(&pck.Settings, limit); !ok {
+		conn.SendPacket(&settings.SettingsErrorPacket{Room: id, Code: int32(code)})
+		return
+	}
+
+	// Categories which can not be assigned by the owner keep the current one.
+	res := <-p.Record(ctx)
+	if res.Error != nil {
+		h.logger.Error("error loading player record for room settings", zap.String("identifier", conn.Identifier()), zap.Error(res.Error))
+		return
+	}
+
+	allowed, err := category.Assignable(ctx, h.db, uint(max(pck.Category, 0)), *res.Data)
+	if err != nil {
+		h.logger.Error("error verifying room settings category", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if !allowed {
+		pck.Category = int32(r.Data().CategoryID)
+	}
+
+	// Settings not persisted are not applied, so the room keeps what is stored.
+	if err := r.SaveSettings(ctx, h.db, &pck.Settings); err != nil {
+		h.logger.Error("error saving room settings", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		conn.SendPacket(&userMsg.AlertPacket{Message: settingsNotSaved})
+		return
+	}
+
+	c := r.Data().Configuration
+	conn.SendPacket(&settings.SettingsSavedPacket{Room: id})
+	r.Broadcast(&settings.ChatSettingsPacket{Settings: room.EncodeSettings(&c)})
+	r.Broadcast(&misc.RoomVisualizationSettingsPacket{
+		FloorSize: int32(c.FloorThickness),
+		WallSize:  int32(c.WallThickness),
+		HideWall:  c.AllowHideWall,
+	})
+	r.Broadcast(&settings.SettingsUpdatedPacket{Room: id})
+	r.Promote()
+
+}
+
+// NewRoomSettings creates a new handler instance.
+func NewRoomSettings() *RoomSettingsHandler {
+	return &RoomSettingsHandler{
+		logger:    server.GetServer().Logger(),
+		cfg:       server.GetServer().Config(),
+		db:        server.GetServer().Database(),
+		roomStore: server.GetServer().RoomStore(),
+		userStore: server.GetServer().UserStore(),
+	}
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room"
+	"pixels-emulator/room/message/unit"
+	"pixels-emulator/user"
+)
+
+// UnitWalkHandler manages the avatar movement requests inside a room.
+type UnitWalkHandler struct {
+	logger    *zap.Logger // logger for packet processing details.
+	roomStore room.Store  // roomStore is the room list to check user current room.
+	userStore user.Store  // userStore is the user store to check user related conn.
+}
+
+// Handle processes the incoming walk packet.
+func (h *UnitWalkHandler) Handle(ctx context.Context, raw protocol.Packet, conn protocol.Connection) {
+
+	pck, ok := raw.(*unit.WalkPacket)
+	if !ok {
+		h.logger.Error("cannot cast walk packet, skipping processing")
+		return
+	}
+
+	p, err := h.userStore.Records().Read(ctx, conn.Identifier())
+	if err != nil {
+		h.logger.Error("connection player not found for walking", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	r, err := room.GetUserRoom(ctx, h.roomStore, p)
+	if err != nil {
+		h.logger.Error("error retrieving player room for walking", zap.String("identifier", conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	if r == nil || !r.IsOnline(p) {
+		h.logger.Debug("player is not in-game, skipping walk", zap.String("identifier", conn.Identifier()))
+		return
+	}
+
+	if !r.Walk(p, int(pck.X), int(pck.Y)) {
+		h.logger.Debug("unreachable walking target", zap.String("identifier", conn.Identifier()), zap.Int32("x", pck.X), zap.Int32("y", pck.Y))
+	}
+
+}
+
+// NewUnitWalk creates a new handler instance.
+func NewUnitWalk() *UnitWalkHandler {
+	return &UnitWalkHandler{
+		logger:    server.GetServer().Logger(),
+		roomStore: server.GetServer().RoomStore(),
+		userStore: server.GetServer().UserStore(),
+	}
+}
@@ -0,0 +1,225 @@
+package room
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"pixels-emulator/core/model"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+	"pixels-emulator/room/message/item"
+	"pixels-emulator/room/path"
+	"sort"
+)
+
+// LoadItems retrieves the items placed in the room, stacking them on the layout.
+func (r *Room) LoadItems(ctx context.Context, db *gorm.DB) error {
+
+	var items []*model.RoomItem
+	if err := db.WithContext(ctx).Preload("Definition").Preload("User").Where("room_id = ?", r.Id).Find(&items).Error; err != nil {
+		return err
+	}
+
+	r.itemMu.Lock()
+	r.items = make(map[uint]*model.RoomItem, len(items))
+	for _, i := range items {
+		r.items[i.ID] = i
+	}
+	r.itemMu.Unlock()
+
+	r.mu.Lock()
+	r.stackAll()
+	r.mu.Unlock()
+	return nil
+
+}
+
+// Items provides the items placed in the room of a type, sorted by identifier.
+func (r *Room) Items(t string) []*model.RoomItem {
+
+	r.itemMu.RLock()
+	defer r.itemMu.RUnlock()
+
+	res := make([]*model.RoomItem, 0, len(r.items))
+	for _, i := range r.items {
+		if i.Definition.Type == t {
+			res = append(res, i)
+		}
+	}
+
+	sort.Slice(res, func(a, b int) bool {
+		return res[a].ID < res[b].ID
+	})
+	return res
+
+}
+
+// ItemsAt provides the floor items covering a tile, from the lowest to the highest.
+func (r *Room) ItemsAt(x, y int) []*model.RoomItem {
+
+	r.itemMu.RLock()
+	defer r.itemMu.RUnlock()
+
+	var res []*model.RoomItem
+	for _, i := range r.items {
+		if i.Definition.Type == model.FloorItem && Covers(i, x, y) {
+			res = append(res, i)
+		}
+	}
+
+	sort.Slice(res, func(a, b int) bool {
+		if res[a].Z != res[b].Z {
+			return res[a].Z < res[b].Z
+		}
+		return res[a].ID < res[b].ID
+	})
+	return res
+
+}
+
+// Size provides the tiles occupied by a floor item along each axis, as rotating
+// it sideways swaps its width and length.
+func Size(i *model.RoomItem) (int, int) {
+	w, l := max(i.Definition.Width, 1), max(i.Definition.Length, 1)
+	if i.Rotation == int(path.East) || i.Rotation == int(path.West) {
+		return l, w
+	}
+	return w, l
+}
+
+// Covers checks if a floor item occupies a tile.
+func Covers(i *model.RoomItem, x, y int) bool {
+	w, l := Size(i)
+	return x >= i.X && x < i.X+w && y >= i.Y && y < i.Y+l
+}
+
+// Stack provides the height, state and stacking of a tile from its base height and
+// the items covering it, from the lowest to the highest. The highest item defines
+// the state and if more items can be stacked.
+func Stack(z float64, items []*model.RoomItem) (float64, path.Status, bool) {
+
+	if len(items) == 0 {
+		return z, path.Open, true
+	}
+
+	h := z
+	for _, i := range items {
+		h = max(h, i.Z+i.Definition.StackHeight)
+	}
+
+	top := items[len(items)-1].Definition
+	switch {
+	case top.AllowSit:
+		return h, path.Sit, top.AllowStack
+	case top.AllowLay:
+		return h, path.Lay, top.AllowStack
+	case top.AllowWalk:
+		return h, path.Open, top.AllowStack
+	default:
+		return h, path.Blocked, top.AllowStack
+	}
+
+}
+
+// stack recomputes the height and state of a tile from the items covering it.
+// The door tile never allows stacking. The room lock must be held.
+func (r *Room) stack(x, y int) {
+
+	if !r.l.TileExists(x, y) {
+		return
+	}
+
+	t := r.l.GetTile(x, y)
+	if t.State == path.Invalid {
+		return
+	}
+
+	h, s, stackable := Stack(float64(t.Z), r.ItemsAt(x, y))
+	t.State = s
+	t.UpdateHeight(h)
+	t.AllowStack(stackable && t != r.l.DoorTile())
+
+}
+
+// stackAll recomputes every tile of the layout. The room lock must be held.
+func (r *Room) stackAll() {
+	_, xLen, yLen := r.l.GetSizes()
+	for x := 0; x < xLen; x++ {
+		for y := 0; y < yLen; y++ {
+			r.stack(x, y)
+		}
+	}
+}
+
+// EncodeFloorItem creates a protocol version of a floor item.
+func EncodeFloorItem(i *model.RoomItem) encode.FloorItem {
+	return encode.FloorItem{
+		ItemId:      int32(i.ID),
+		Sprite:      int32(i.Definition.SpriteID),
+		X:           int32(i.X),
+		Y:           int32(i.Y),
+		Rotation:    int32(i.Rotation),
+		Z:           i.Z,
+		StackHeight: i.Definition.StackHeight,
+		Data:        i.ExtraData,
+		Serial:      int32(i.LimitedNumber),
+		Series:      int32(i.LimitedStack),
+		Expiration:  encode.NoExpiration,
+		Usage:       usage(&i.Definition),
+		OwnerId:     int32(i.UserID),
+	}
+}
+
+// EncodeWallItem creates a protocol version of a wall item.
+func EncodeWallItem(i *model.RoomItem) encode.WallItem {
+	return encode.WallItem{
+		ItemId:     int32(i.ID),
+		Sprite:     int32(i.Definition.SpriteID),
+		Position:   i.WallPosition,
+		Data:       i.ExtraData,
+		Expiration: encode.NoExpiration,
+		Usage:      usage(&i.Definition),
+		OwnerId:    int32(i.UserID),
+	}
+}
+
+// usage provides who can use the items of a kind, only controllers if they have states.
+func usage(d *model.ItemDefinition) encode.Usage {
+	if d.InteractionModes > 1 {
+		return encode.UsageController
+	}
+	return encode.UsageNobody
+}
+
+// SendItems sends the floor and wall items of the room to a connection.
+func (r *Room) SendItems(conn protocol.Connection) {
+
+	floor := r.Items(model.FloorItem)
+	fPck := &item.FloorItemsPacket{Owners: owners(floor), Items: make([]encode.FloorItem, len(floor))}
+	for n, i := range floor {
+		fPck.Items[n] = EncodeFloorItem(i)
+	}
+
+	wall := r.Items(model.WallItem)
+	wPck := &item.WallItemsPacket{Owners: owners(wall), Items: make([]encode.WallItem, len(wall))}
+	for n, i := range wall {
+		wPck.Items[n] = EncodeWallItem(i)
+	}
+
+	conn.SendPacket(fPck)
+	conn.SendPacket(wPck)
+
+}
+
+// owners provides the distinct owners of the items.
+func owners(items []*model.RoomItem) []item.Owner {
+	seen := make(map[uint]bool)
+	var res []item.Owner
+	for _, i := range items {
+		if seen[i.UserID] {
+			continue
+		}
+		seen[i.UserID] = true
+		res = append(res, item.Owner{UserId: int32(i.UserID), Name: i.User.Username})
+	}
+	return res
+}
@@ -0,0 +1,71 @@
+package room
+
+import (
+	"pixels-emulator/core/model"
+	"pixels-emulator/room/path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// furni creates a floor item of a kind placed at a position.
+func furni(id uint, x, y int, z float64, rot int, d model.ItemDefinition) *model.RoomItem {
+	d.Type = model.FloorItem
+	i := &model.RoomItem{X: x, Y: y, Z: z, Rotation: rot, Definition: d}
+	i.ID = id
+	return i
+}
+
+// TestCovers verifies rotated items swap their width and length.
+func TestCovers(t *testing.T) {
+	sofa := furni(1, 2, 2, 0, 0, model.ItemDefinition{Width: 2, Length: 1})
+	assert.True(t, Covers(sofa, 3, 2))
+	assert.False(t, Covers(sofa, 2, 3))
+
+	sofa.Rotation = int(path.East)
+	assert.False(t, Covers(sofa, 3, 2))
+	assert.True(t, Covers(sofa, 2, 3))
+}
+
+// TestStack verifies the highest item defines the state of the tile.
+func TestStack(t *testing.T) {
+	h, s, stackable := Stack(1, nil)
+	assert.Equal(t, 1.0, h)
+	assert.Equal(t, path.Status(path.Open), s)
+	assert.True(t, stackable)
+
+	table := furni(1, 0, 0, 0, 0, model.ItemDefinition{StackHeight: 0.7, AllowStack: true})
+	chair := furni(2, 0, 0, 0.7, 0, model.ItemDefinition{StackHeight: 1, AllowSit: true})
+
+	h, s, stackable = Stack(0, []*model.RoomItem{table})
+	assert.Equal(t, 0.7, h)
+	assert.Equal(t, path.Status(path.Blocked), s)
+	assert.True(t, stackable)
+
+	h, s, stackable = Stack(0, []*model.RoomItem{table, chair})
+	assert.Equal(t, 1.7, h)
+	assert.Equal(t, path.Status(path.Sit), s)
+	assert.False(t, stackable)
+}
+
+// TestRoom_stackAll verifies the layout tiles are updated from the items placed.
+func TestRoom_stackAll(t *testing.T) {
+	l, err := path.NewLayout(&model.HeightMap{Heightmap: "0000\\r\\n0000\\r\\n0000", DoorX: 0, DoorY: 0, DoorDirection: 2})
+	assert.NoError(t, err)
+
+	r := &Room{l: l, items: map[uint]*model.RoomItem{
+		1: furni(1, 1, 1, 0, 0, model.ItemDefinition{Width: 2, Length: 1, StackHeight: 1, AllowSit: true}),
+		2: furni(2, 0, 0, 0, 0, model.ItemDefinition{StackHeight: 0.5, AllowWalk: true, AllowStack: true}),
+	}}
+	r.stackAll()
+
+	for _, x := range []int{1, 2} {
+		tile := l.GetTile(x, 1)
+		assert.Equal(t, path.Status(path.Sit), tile.State)
+		assert.Equal(t, 1.0, tile.Height())
+	}
+
+	assert.Equal(t, path.Status(path.Open), l.GetTile(3, 1).State)
+	assert.Equal(t, 0.5, l.GetTile(0, 0).Height())
+	assert.False(t, l.GetTile(0, 0).Stackable(), "door tile must not allow stacking")
+}
@@ -0,0 +1,29 @@
+package room
+
+import (
+	ev "pixels-emulator/room/event"
+	"pixels-emulator/room/message/unit"
+	"pixels-emulator/user"
+)
+
+// Leave removes a player from the room, notifying the remaining players
+// if the player was in-game and promoting the enqueued ones. Returns false if the player was not in the room.
+func (r *Room) Leave(p *user.Player) bool {
+
+	online := r.IsOnline(p)
+	if !online && !r.IsTransitioning(p) && !r.Queue.Contains(p.Id) && !r.IsRinging(p) {
+		return false
+	}
+
+	r.Clear(p.Id)
+
+	if online {
+		r.Broadcast(&unit.RemovePacket{Unit: p.Unit().Id})
+	}
+
+	r.Promote()
+
+	r.em.Fire(ev.RoomLeaveEventName, ev.NewRoomLeaveEvent(r.Id, p.Id, 0, make(map[string]string)))
+	return true
+
+}
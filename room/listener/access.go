@@ -2,7 +2,9 @@ package listener
 
 import (
 	"context"
+	"errors"
 	"go.uber.org/zap"
+	"gorm.io/gorm"
 	"pixels-emulator/core/database"
 	"pixels-emulator/core/event"
 	"pixels-emulator/core/model"
@@ -10,6 +12,7 @@ import (
 	"pixels-emulator/room"
 	roomEvent "pixels-emulator/room/event"
 	"pixels-emulator/room/message"
+	"pixels-emulator/room/restriction"
 	"strconv"
 	"strings"
 	"time"
@@ -23,6 +26,12 @@ func ProvideRoomLoadRequest() func(event event.Event) {
 	}
 }
 
+// admitAttempts defines how many times a player is admitted into a room unloaded meanwhile.
+const admitAttempts = 3
+
+// errRoomUnloaded rejects players which could not be admitted into a loaded room.
+var errRoomUnloaded = errors.New("room unloaded while admitting the player")
+
 // OnRoomLoadRequest encapsulates the room provisioning
 // for users which have granted access.
 func OnRoomLoadRequest(ev event.Event) {
@@ -60,25 +69,68 @@ func OnRoomLoadRequest(ev event.Event) {
 		return
 	}
 
-	r, lErr := rStore.Records().Read(ctx, strconv.Itoa(int(accEv.Room)))
-	if lErr != nil {
+	uid, err := strconv.Atoi(p.Id)
+	if err != nil {
+		return
+	}
+
+	until, err := restriction.MutedUntil(ctx, db, accEv.Room, uint(uid))
+	if err != nil {
+		return
+	}
+
+	// Rooms unloaded while the player was being admitted are loaded again.
+	for attempt := 0; ; attempt++ {
 
-		if !strings.Contains(lErr.Error(), "key not found") {
-			err = lErr
+		if attempt == admitAttempts {
+			err = errRoomUnloaded
 			return
 		}
 
-		r, err = room.Load(rRes.Data, server.GetServer().Logger(), server.GetServer().EventManager())
-		if err != nil {
+		r, rErr := provide(ctx, rStore, db, rRes.Data)
+		if rErr != nil {
+			err = rErr
 			return
 		}
-		err = rStore.Records().Create(ctx, strconv.Itoa(int(r.Id)), r)
-		if err != nil {
+
+		if !until.IsZero() {
+			r.Mute(p.Id, until)
+		}
+
+		if r.Open(p, nil, !accEv.SkipQueue) {
 			return
 		}
 
 	}
 
-	r.Open(p, nil)
+}
+
+// provide retrieves the loaded room, loading it with its items if not loaded yet.
+// Loads of the same room are serialized, so concurrent players share one room.
+func provide(ctx context.Context, rStore room.Store, db *gorm.DB, data *model.Room) (*room.Room, error) {
+
+	key := strconv.Itoa(int(data.ID))
+	unlock := rStore.Loads().Lock(key)
+	defer unlock()
+
+	r, err := rStore.Records().Read(ctx, key)
+	if err == nil {
+		return r, nil
+	}
+	if !strings.Contains(err.Error(), "key not found") {
+		return nil, err
+	}
+
+	r, err = room.Load(data, server.GetServer().Logger(), server.GetServer().EventManager(), server.GetServer().Ticker())
+	if err != nil {
+		return nil, err
+	}
+	if err := r.LoadItems(ctx, db); err != nil {
+		return nil, err
+	}
+	if err := rStore.Records().Create(ctx, key, r); err != nil {
+		return nil, err
+	}
+	return r, nil
 
 }
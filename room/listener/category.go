@@ -0,0 +1,65 @@
+package listener
+
+import (
+	"context"
+	"go.uber.org/zap"
+	authEvent "pixels-emulator/auth/event"
+	"pixels-emulator/core/event"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room/category"
+	msg "pixels-emulator/room/message/category"
+	"strconv"
+	"time"
+)
+
+// ProvideFlatCategories sends the room categories to the users once logged in.
+// It should handle the authentication event after the login is granted.
+func ProvideFlatCategories() func(event event.Event) {
+	return func(event event.Event) {
+		OnFlatCategories(event)
+	}
+}
+
+// OnFlatCategories sends the room categories to a logged user.
+func OnFlatCategories(ev event.Event) {
+
+	authEv, valid := ev.(*authEvent.AuthGrantEvent)
+	if !valid {
+		server.GetServer().Logger().Error("event proportioned was not authentication, skipping")
+		return
+	}
+
+	if authEv.IsCancelled() {
+		return
+	}
+
+	sv := server.GetServer()
+	var err error
+	defer func() {
+		if err != nil {
+			sv.Logger().Error("error sending room categories", zap.Int("user", authEv.UserID()), zap.Error(err))
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	p, err := sv.UserStore().Records().Read(ctx, strconv.Itoa(authEv.UserID()))
+	if err != nil {
+		return
+	}
+
+	res := <-p.Record(ctx)
+	if res.Error != nil {
+		err = res.Error
+		return
+	}
+
+	cats, err := category.All(ctx, sv.Database())
+	if err != nil {
+		return
+	}
+
+	p.Conn().SendPacket(&msg.FlatCategoriesPacket{Categories: category.Encode(cats, *res.Data)})
+
+}
@@ -0,0 +1,67 @@
+package listener
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"pixels-emulator/core/event"
+	"pixels-emulator/core/server"
+	roomEvent "pixels-emulator/room/event"
+	"pixels-emulator/room/message/chat"
+	"strconv"
+	"time"
+)
+
+// ProvideRoomChat delivers the chat messages which
+// were not cancelled by previous listeners.
+func ProvideRoomChat() func(event event.Event) {
+	return func(event event.Event) {
+		OnRoomChat(event)
+	}
+}
+
+// OnRoomChat delivers the chat message to the players
+// able to receive it.
+func OnRoomChat(ev event.Event) {
+
+	chatEv, valid := ev.(*roomEvent.RoomChatEvent)
+	if !valid {
+		server.GetServer().Logger().Error("event proportioned was not room chat, skipping")
+		return
+	}
+
+	if chatEv.IsCancelled() || chatEv.Message == "" {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	p, err := server.GetServer().UserStore().Records().Read(ctx, chatEv.Conn.Identifier())
+	if err != nil {
+		server.GetServer().Logger().Error("chat speaker not found", zap.String("identifier", chatEv.Conn.Identifier()), zap.Error(err))
+		return
+	}
+
+	r, err := server.GetServer().RoomStore().Records().Read(ctx, strconv.Itoa(int(chatEv.Room)))
+	if err != nil {
+		server.GetServer().Logger().Error("chat room not found", zap.Uint("room", chatEv.Room), zap.Error(err))
+		return
+	}
+
+	if !r.IsOnline(p) {
+		return
+	}
+
+	if chatEv.Type != chat.Whisper {
+		r.Chat(p, chatEv.Type, chatEv.Message, chatEv.Bubble, nil)
+		return
+	}
+
+	target := r.PlayerByName(chatEv.Target)
+	if target == nil {
+		return
+	}
+
+	r.Chat(p, chatEv.Type, chatEv.Message, chatEv.Bubble, target)
+
+}
@@ -0,0 +1,70 @@
+package listener
+
+import (
+	"context"
+	"pixels-emulator/core/event"
+	"pixels-emulator/core/model"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room/chatlog"
+	roomEvent "pixels-emulator/room/event"
+	"pixels-emulator/room/message/chat"
+	"strconv"
+	"time"
+)
+
+// ProvideChatLog records the delivered chat messages
+// for moderation purposes.
+func ProvideChatLog() func(event event.Event) {
+	return func(event event.Event) {
+		OnChatLog(event)
+	}
+}
+
+// OnChatLog buffers the chat message into the chat log writer.
+func OnChatLog(ev event.Event) {
+
+	chatEv, valid := ev.(*roomEvent.RoomChatEvent)
+	if !valid {
+		server.GetServer().Logger().Error("event proportioned was not room chat, skipping")
+		return
+	}
+
+	if chatEv.IsCancelled() || chatEv.Message == "" {
+		return
+	}
+
+	uid, err := strconv.ParseUint(chatEv.Conn.Identifier(), 10, 32)
+	if err != nil {
+		return
+	}
+
+	l := model.ChatLog{
+		RoomID:  chatEv.Room,
+		UserID:  uint(uid),
+		Type:    int(chatEv.Type),
+		Message: chatEv.Message,
+	}
+
+	if chatEv.Type == chat.Whisper {
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		r, rErr := server.GetServer().RoomStore().Records().Read(ctx, strconv.Itoa(int(chatEv.Room)))
+		if rErr != nil {
+			return
+		}
+
+		target := r.PlayerByName(chatEv.Target)
+		if target == nil {
+			return
+		}
+
+		tid, _ := strconv.ParseUint(target.Id, 10, 32)
+		l.TargetID = uint(tid)
+
+	}
+
+	chatlog.GetWriter().Record(l)
+
+}
@@ -40,8 +40,13 @@ func OnRoomConnectionClose(ev event.Event) {
 		return
 	}
 
+	p, pErr := server.GetServer().UserStore().Records().Read(context.Background(), cEv.Connection.Identifier())
 	for _, room := range rooms {
-		room.Clear(cEv.Connection.Identifier())
+		if pErr != nil {
+			room.Clear(cEv.Connection.Identifier())
+			continue
+		}
+		room.Leave(p)
 	}
 
 }
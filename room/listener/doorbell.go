@@ -0,0 +1,105 @@
+package listener
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"pixels-emulator/core/event"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room"
+	roomEvent "pixels-emulator/room/event"
+	"pixels-emulator/room/message"
+	"pixels-emulator/room/message/doorbell"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ProvideRoomDoorbell rings the doorbell of a locked room
+// to the players which are able to let the user in.
+func ProvideRoomDoorbell() func(event event.Event) {
+	return func(event event.Event) {
+		OnRoomDoorbell(event)
+	}
+}
+
+// OnRoomDoorbell rings the doorbell of a locked room to the in-game owner and players
+// with rights. If no one can answer, the ringer is rejected immediately, otherwise
+// the ring is rejected as unanswered once room.DoorbellTimeout passes.
+func OnRoomDoorbell(ev event.Event) {
+
+	dEv, valid := ev.(*roomEvent.RoomDoorbellEvent)
+	if !valid {
+		server.GetServer().Logger().Error("event proportioned was not room doorbell, skipping")
+		return
+	}
+
+	sv := server.GetServer()
+
+	var err error
+	defer func() {
+		if err != nil {
+			sv.Logger().Error("error during room doorbell", zap.Error(err))
+			room.CloseConnection(dEv.Conn, message.Default, "", sv.EventManager())
+		}
+	}()
+
+	if dEv.IsCancelled() {
+		room.CloseConnection(dEv.Conn, message.Default, "", sv.EventManager())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	p, err := sv.UserStore().Records().Read(ctx, dEv.Conn.Identifier())
+	if err != nil {
+		return
+	}
+
+	r, lErr := sv.RoomStore().Records().Read(ctx, strconv.Itoa(int(dEv.Room)))
+	if lErr != nil {
+		if !strings.Contains(lErr.Error(), "key not found") {
+			err = lErr
+			return
+		}
+		dEv.Conn.SendPacket(&doorbell.DoorbellRejectedPacket{})
+		return
+	}
+
+	cs, err := r.Controllers(ctx, sv.Database())
+	if err != nil {
+		return
+	}
+
+	if len(cs) == 0 {
+		dEv.Conn.SendPacket(&doorbell.DoorbellRejectedPacket{})
+		return
+	}
+
+	ring := r.Ring(p)
+	for _, c := range cs {
+		c.Conn().SendPacket(&doorbell.DoorbellPacket{Username: p.Username})
+	}
+
+	sv.Scheduler().ScheduleTaskLater(room.DoorbellTimeout, func() {
+
+		if !r.Answer(ring, false) {
+			return
+		}
+
+		tCtx, tCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer tCancel()
+
+		cs, err := r.Controllers(tCtx, sv.Database())
+		if err != nil {
+			sv.Logger().Error("error notifying unanswered doorbell", zap.Error(err))
+			return
+		}
+
+		for _, c := range cs {
+			c.Conn().SendPacket(&doorbell.DoorbellRejectedPacket{Username: p.Username})
+		}
+
+	})
+
+}
@@ -0,0 +1,83 @@
+package listener
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"pixels-emulator/core/event"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room"
+	roomEvent "pixels-emulator/room/event"
+	"strconv"
+	"time"
+)
+
+// ProvideRoomEnter encapsulates the room session setup
+// for players placed in a room.
+func ProvideRoomEnter() func(event event.Event) {
+	return func(event event.Event) {
+		OnRoomEnter(event)
+	}
+}
+
+// OnRoomEnter sends the room session details to a player once placed in the room,
+// either admitted directly or promoted from the queue. The room rights, score and
+// promotion are sent and the visit is recorded.
+func OnRoomEnter(ev event.Event) {
+
+	eEv, valid := ev.(*roomEvent.RoomEnterEvent)
+	if !valid {
+		server.GetServer().Logger().Error("event proportioned was not room enter, skipping")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	logger := server.GetServer().Logger()
+	db := server.GetServer().Database()
+
+	p, err := server.GetServer().UserStore().Records().Read(ctx, eEv.Player)
+	if err != nil {
+		logger.Error("connection player not found for room enter", zap.String("identifier", eEv.Player), zap.Error(err))
+		return
+	}
+
+	r, err := server.GetServer().RoomStore().Records().Read(ctx, strconv.Itoa(int(eEv.Room)))
+	if err != nil {
+		logger.Error("error retrieving entered room", zap.String("identifier", eEv.Player), zap.Error(err))
+		return
+	}
+
+	if !r.IsOnline(p) {
+		return
+	}
+
+	uid, err := strconv.Atoi(p.Id)
+	if err != nil {
+		logger.Error("invalid player identifier on room enter", zap.String("identifier", p.Id), zap.Error(err))
+		return
+	}
+
+	rel, err := r.Relationship(ctx, db, p)
+	if err != nil {
+		logger.Error("error verifying room relationship", zap.String("identifier", p.Id), zap.Error(err))
+		return
+	}
+
+	if l := room.Level(rel); l != room.NoControl {
+		r.Control(p, l)
+	}
+
+	if sErr := r.SendScore(ctx, db, p); sErr != nil {
+		logger.Error("error sending room score", zap.String("identifier", p.Id), zap.Error(sErr))
+	}
+
+	if d, now := r.Data(), time.Now(); room.Promoted(&d, now) {
+		p.Conn().SendPacket(room.EncodePromotion(&d, now))
+	}
+
+	if vErr := room.Visit(ctx, db, r.Id, uint(uid)); vErr != nil {
+		logger.Error("error recording room visit", zap.String("identifier", p.Id), zap.Error(vErr))
+	}
+
+}
@@ -76,10 +76,19 @@ func OnUserRoomJoin(ev event.Event) {
 		return
 	}
 
-	// Removes from every queue the user.
+	// Leaves the current room, or any queue, before opening the new one.
 	rl, err := rStore.Records().GetAll(ctx)
+	if err != nil {
+		return
+	}
+
+	p, pErr := server.GetServer().UserStore().Records().Read(ctx, joinEv.Conn.Identifier())
 	for _, r := range rl {
-		r.Queue.Remove(strconv.Itoa(int(uRes.Data.ID)))
+		if pErr != nil {
+			r.Queue.Remove(strconv.Itoa(int(uRes.Data.ID)))
+			continue
+		}
+		r.Leave(p)
 	}
 
 	rRes := <-rSvc.Get(ctx, uint(joinEv.Id))
@@ -101,8 +110,9 @@ func OnUserRoomJoin(ev event.Event) {
 
 	rs := rRes.Data.State
 	accEv := roomEvent.NewRoomLoadRequestEvent(joinEv.Conn, uint(joinEv.Id), 0, make(map[string]string))
+	accEv.SkipQueue = rel != room.Guest
 
-	if rel != room.Guest || joinEv.OverrideCheck || rRes.Data.IsPublic || rs == "open" {
+	if rel != room.Guest || joinEv.OverrideCheck || rRes.Data.IsPublic || rs == room.StateOpen {
 		server.GetServer().EventManager().Fire(roomEvent.RoomLoadRequestEventName, accEv)
 		return
 	}
@@ -110,7 +120,7 @@ func OnUserRoomJoin(ev event.Event) {
 	// INVESTIGATION: Nitro client checks if room is part of a group before
 	// prompting password. So, the ideal is not to have password on guild groups.
 	// Also, creating a cleaning cronjob will be cool too.
-	if rs == "password_protected" {
+	if rs == room.StatePassword {
 
 		u, r := strconv.Itoa(int(uRes.Data.ID)), strconv.Itoa(int(rRes.Data.ID))
 
@@ -138,10 +148,10 @@ func OnUserRoomJoin(ev event.Event) {
 
 	}
 
-	if false {
-		// Doorbelling. This must change from original Arcturus implementation, room must have doorbelling ids and broadcast event
-		// This will also have a runnable of 1 minute, runnable must cancel if not in room users. After 1 minute, send message of no one answered.
-		// When user with rights join, we should send again after 3 seconds the message.
+	if rs == room.StateLocked {
+		dEv := roomEvent.NewRoomDoorbellEvent(joinEv.Conn, uint(joinEv.Id), 0, make(map[string]string))
+		server.GetServer().EventManager().Fire(roomEvent.RoomDoorbellEventName, dEv)
+		return
 	}
 
 	room.CloseConnection(joinEv.Conn, message.Default, "", server.GetServer().EventManager())
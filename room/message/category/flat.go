@@ -0,0 +1,51 @@
+package category
+
+import "pixels-emulator/core/protocol"
+
+// FlatCategoriesCode is the unique identifier for the packet
+const FlatCategoriesCode = 1562
+
+// FlatCategory defines a category rooms can be assigned to.
+type FlatCategory struct {
+	Id        int32  // Id is the identifier of the category.
+	Caption   string // Caption is the display name of the category.
+	Visible   bool   // Visible determines if the category can be selected by the player.
+	StaffOnly bool   // StaffOnly determines if the category requires a permission to be assigned.
+}
+
+// FlatCategoriesPacket provides the categories rooms can be assigned to.
+type FlatCategoriesPacket struct {
+	Categories []FlatCategory // Categories are the room categories.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *FlatCategoriesPacket) Id() uint16 {
+	return FlatCategoriesCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *FlatCategoriesPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *FlatCategoriesPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *FlatCategoriesPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(FlatCategoriesCode)
+	pck.AddInt(int32(len(p.Categories)))
+	for _, c := range p.Categories {
+		pck.AddInt(c.Id)
+		pck.AddString(c.Caption)
+		pck.AddBoolean(c.Visible)
+		pck.AddBoolean(false) // Automatic categories are not supported.
+		pck.AddString("")
+		pck.AddString("")
+		pck.AddBoolean(c.StaffOnly)
+	}
+	return pck
+}
@@ -0,0 +1,42 @@
+package category
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestFlatCategoriesPacket_Serialize verifies the packet serialization.
+func TestFlatCategoriesPacket_Serialize(t *testing.T) {
+	pck := &FlatCategoriesPacket{Categories: []FlatCategory{{Id: 1, Caption: "Games", Visible: true}, {Id: 2, Caption: "Staff", StaffOnly: true}}}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	n, _ := raw.ReadInt()
+	assert.Equal(t, int32(2), n)
+
+	for _, c := range pck.Categories {
+		id, _ := raw.ReadInt()
+		caption, _ := raw.ReadString()
+		visible, _ := raw.ReadBoolean()
+		automatic, _ := raw.ReadBoolean()
+		_, _ = raw.ReadString()
+		_, _ = raw.ReadString()
+		staff, err := raw.ReadBoolean()
+		assert.NoError(t, err)
+		assert.False(t, automatic)
+		assert.Equal(t, c, FlatCategory{Id: id, Caption: caption, Visible: visible, StaffOnly: staff})
+	}
+}
+
+// TestFlatCategoriesPacket_Integrity tests packet ID, deadline, and rate.
+func TestFlatCategoriesPacket_Integrity(t *testing.T) {
+	pck := &FlatCategoriesPacket{}
+	assert.Equal(t, uint16(FlatCategoriesCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
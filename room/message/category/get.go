@@ -0,0 +1,31 @@
+package category
+
+import "pixels-emulator/core/protocol"
+
+// GetFlatCategoriesCode is the unique identifier for the packet
+const GetFlatCategoriesCode = 3027
+
+// GetFlatCategoriesPacket represents a packet sent by client to list the categories rooms can be assigned to.
+type GetFlatCategoriesPacket struct {
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *GetFlatCategoriesPacket) Id() uint16 {
+	return GetFlatCategoriesCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *GetFlatCategoriesPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *GetFlatCategoriesPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeGetFlatCategoriesPacket composes a new instance of the packet.
+func ComposeGetFlatCategoriesPacket(_ protocol.RawPacket) (*GetFlatCategoriesPacket, error) {
+	return &GetFlatCategoriesPacket{}, nil
+}
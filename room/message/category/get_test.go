@@ -0,0 +1,25 @@
+package category
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeGetFlatCategoriesPacket verifies the packet is composed without content.
+func TestComposeGetFlatCategoriesPacket(t *testing.T) {
+	pck, err := ComposeGetFlatCategoriesPacket(protocol.NewPacket(GetFlatCategoriesCode))
+	assert.NoError(t, err)
+	assert.NotNil(t, pck)
+}
+
+// TestGetFlatCategoriesPacket_Integrity tests packet ID, deadline, and rate.
+func TestGetFlatCategoriesPacket_Integrity(t *testing.T) {
+	pck := &GetFlatCategoriesPacket{}
+	assert.Equal(t, uint16(GetFlatCategoriesCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,34 @@
+package chat
+
+import "pixels-emulator/core/protocol"
+
+// FloodControlCode is the unique identifier for the packet
+const FloodControlCode = 566
+
+// FloodControlPacket notifies the player is muted by flooding the chat.
+type FloodControlPacket struct {
+	Seconds int32 // Seconds defines the remaining time of the mute.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *FloodControlPacket) Id() uint16 {
+	return FloodControlCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *FloodControlPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *FloodControlPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *FloodControlPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(FloodControlCode)
+	pck.AddInt(p.Seconds)
+	return pck
+}
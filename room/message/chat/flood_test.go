@@ -0,0 +1,30 @@
+package chat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestFloodControlPacket_Serialize verifies the packet serialization.
+func TestFloodControlPacket_Serialize(t *testing.T) {
+	pck := &FloodControlPacket{Seconds: 30}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	s, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(30), s)
+}
+
+// TestFloodControlPacket_Integrity tests packet ID, deadline, and rate.
+func TestFloodControlPacket_Integrity(t *testing.T) {
+	pck := &FloodControlPacket{}
+	assert.Equal(t, uint16(FloodControlCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
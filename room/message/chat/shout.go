@@ -0,0 +1,45 @@
+package chat
+
+import "pixels-emulator/core/protocol"
+
+// ShoutCode is the unique identifier for the packet
+const ShoutCode = 2085
+
+// ShoutPacket defines a chat message sent to every player in the room.
+type ShoutPacket struct {
+	Message string // Message defines the text sent.
+	Bubble  int32  // Bubble defines the chat bubble style.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *ShoutPacket) Id() uint16 {
+	return ShoutCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *ShoutPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *ShoutPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeShoutPacket composes a new instance of the packet.
+func ComposeShoutPacket(pck protocol.RawPacket) (*ShoutPacket, error) {
+
+	msg, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	bubble, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ShoutPacket{Message: msg, Bubble: bubble}, nil
+
+}
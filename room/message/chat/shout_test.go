@@ -0,0 +1,25 @@
+package chat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestComposeShoutPacket verifies the packet is decoded correctly.
+func TestComposeShoutPacket(t *testing.T) {
+	pck, err := ComposeShoutPacket(encodeChatMessage(ShoutCode, "HELLO", 1))
+	assert.NoError(t, err)
+	assert.Equal(t, "HELLO", pck.Message)
+	assert.Equal(t, int32(1), pck.Bubble)
+}
+
+// TestShoutPacket_Integrity tests packet ID, deadline, and rate.
+func TestShoutPacket_Integrity(t *testing.T) {
+	pck := &ShoutPacket{}
+	assert.Equal(t, uint16(ShoutCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,45 @@
+package chat
+
+import "pixels-emulator/core/protocol"
+
+// TalkCode is the unique identifier for the packet
+const TalkCode = 1314
+
+// TalkPacket defines a chat message sent to the nearby players.
+type TalkPacket struct {
+	Message string // Message defines the text sent.
+	Bubble  int32  // Bubble defines the chat bubble style.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *TalkPacket) Id() uint16 {
+	return TalkCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *TalkPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *TalkPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeTalkPacket composes a new instance of the packet.
+func ComposeTalkPacket(pck protocol.RawPacket) (*TalkPacket, error) {
+
+	msg, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	bubble, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &TalkPacket{Message: msg, Bubble: bubble}, nil
+
+}
@@ -0,0 +1,44 @@
+package chat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// encodeChatMessage creates a raw incoming chat packet.
+func encodeChatMessage(code uint16, msg string, bubble int32) protocol.RawPacket {
+	pck := protocol.NewPacket(code)
+	pck.AddString(msg)
+	pck.AddInt(bubble)
+	raw, _ := protocol.FromBytes(pck.ToBytes())
+	return *raw
+}
+
+// TestComposeTalkPacket verifies the packet is decoded correctly.
+func TestComposeTalkPacket(t *testing.T) {
+	pck, err := ComposeTalkPacket(encodeChatMessage(TalkCode, "hello", 3))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", pck.Message)
+	assert.Equal(t, int32(3), pck.Bubble)
+}
+
+// TestComposeTalkPacket_Missing verifies incomplete packets are rejected.
+func TestComposeTalkPacket_Missing(t *testing.T) {
+	pck := protocol.NewPacket(TalkCode)
+	pck.AddString("hello")
+	raw, _ := protocol.FromBytes(pck.ToBytes())
+	_, err := ComposeTalkPacket(*raw)
+	assert.Error(t, err)
+}
+
+// TestTalkPacket_Integrity tests packet ID, deadline, and rate.
+func TestTalkPacket_Integrity(t *testing.T) {
+	pck := &TalkPacket{}
+	assert.Equal(t, uint16(TalkCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,13 @@
+package chat
+
+// Type defines the way a chat message is delivered.
+type Type int
+
+const (
+	Talk    Type = iota // Talk is delivered to the players within hearing distance.
+	Shout               // Shout is delivered to every player in the room.
+	Whisper             // Whisper is only delivered to the target player.
+)
+
+// MaxLength defines the maximum length of a chat message.
+const MaxLength = 100
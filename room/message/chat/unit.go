@@ -0,0 +1,53 @@
+package chat
+
+import "pixels-emulator/core/protocol"
+
+const (
+	UnitTalkCode    = 1446 // UnitTalkCode is the unique identifier for the talk packet
+	UnitShoutCode   = 1036 // UnitShoutCode is the unique identifier for the shout packet
+	UnitWhisperCode = 2704 // UnitWhisperCode is the unique identifier for the whisper packet
+)
+
+// UnitChatPacket defines a chat message said by a room unit.
+type UnitChatPacket struct {
+	Type    Type   // Type defines the delivery of the message.
+	Unit    int32  // Unit defines the room unit which said the message.
+	Message string // Message defines the text said.
+	Gesture int32  // Gesture defines the avatar expression while talking.
+	Bubble  int32  // Bubble defines the chat bubble style.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *UnitChatPacket) Id() uint16 {
+	switch p.Type {
+	case Shout:
+		return UnitShoutCode
+	case Whisper:
+		return UnitWhisperCode
+	default:
+		return UnitTalkCode
+	}
+}
+
+// Rate returns the rate limit for the packet.
+func (p *UnitChatPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *UnitChatPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *UnitChatPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(p.Id())
+	pck.AddInt(p.Unit)
+	pck.AddString(p.Message)
+	pck.AddInt(p.Gesture)
+	pck.AddInt(p.Bubble)
+	pck.AddInt(0) // Links of the message.
+	pck.AddInt(int32(len(p.Message)))
+	return pck
+}
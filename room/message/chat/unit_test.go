@@ -0,0 +1,49 @@
+package chat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestUnitChatPacket_Serialize verifies the packet serialization.
+func TestUnitChatPacket_Serialize(t *testing.T) {
+	pck := &UnitChatPacket{Type: Talk, Unit: 5, Message: "hello", Gesture: 1, Bubble: 2}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(UnitTalkCode), raw.GetHeader())
+
+	unit, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), unit)
+
+	msg, err := raw.ReadString()
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", msg)
+
+	gesture, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), gesture)
+
+	bubble, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), bubble)
+
+	links, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), links)
+
+	length, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), length)
+}
+
+// TestUnitChatPacket_Id verifies the identifier depends on the chat type.
+func TestUnitChatPacket_Id(t *testing.T) {
+	assert.Equal(t, uint16(UnitTalkCode), (&UnitChatPacket{Type: Talk}).Id())
+	assert.Equal(t, uint16(UnitShoutCode), (&UnitChatPacket{Type: Shout}).Id())
+	assert.Equal(t, uint16(UnitWhisperCode), (&UnitChatPacket{Type: Whisper}).Id())
+	assert.Equal(t, uint(0), (&UnitChatPacket{}).Deadline())
+}
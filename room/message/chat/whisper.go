@@ -0,0 +1,57 @@
+package chat
+
+import (
+	"errors"
+	"pixels-emulator/core/protocol"
+	"strings"
+)
+
+// WhisperCode is the unique identifier for the packet
+const WhisperCode = 1543
+
+// WhisperPacket defines a chat message only sent to a specific player.
+type WhisperPacket struct {
+	Target  string // Target defines the username of the receiver.
+	Message string // Message defines the text sent.
+	Bubble  int32  // Bubble defines the chat bubble style.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *WhisperPacket) Id() uint16 {
+	return WhisperCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *WhisperPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *WhisperPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeWhisperPacket composes a new instance of the packet.
+// Nitro sends the target username and the message in the same string
+// separated by the first space.
+func ComposeWhisperPacket(pck protocol.RawPacket) (*WhisperPacket, error) {
+
+	raw, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	bubble, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	target, msg, found := strings.Cut(raw, " ")
+	if !found || target == "" {
+		return nil, errors.New("whisper target not provided")
+	}
+
+	return &WhisperPacket{Target: target, Message: msg, Bubble: bubble}, nil
+
+}
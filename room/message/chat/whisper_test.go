@@ -0,0 +1,35 @@
+package chat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestComposeWhisperPacket verifies target and message are split.
+func TestComposeWhisperPacket(t *testing.T) {
+	pck, err := ComposeWhisperPacket(encodeChatMessage(WhisperCode, "john hello there", 2))
+	assert.NoError(t, err)
+	assert.Equal(t, "john", pck.Target)
+	assert.Equal(t, "hello there", pck.Message)
+	assert.Equal(t, int32(2), pck.Bubble)
+}
+
+// TestComposeWhisperPacket_NoTarget verifies whispers without target are rejected.
+func TestComposeWhisperPacket_NoTarget(t *testing.T) {
+	_, err := ComposeWhisperPacket(encodeChatMessage(WhisperCode, "john", 2))
+	assert.Error(t, err)
+
+	_, err = ComposeWhisperPacket(encodeChatMessage(WhisperCode, " hello", 2))
+	assert.Error(t, err)
+}
+
+// TestWhisperPacket_Integrity tests packet ID, deadline, and rate.
+func TestWhisperPacket_Integrity(t *testing.T) {
+	pck := &WhisperPacket{}
+	assert.Equal(t, uint16(WhisperCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
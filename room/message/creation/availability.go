@@ -0,0 +1,36 @@
+package creation
+
+import "pixels-emulator/core/protocol"
+
+// CanCreateRoomResultCode is the unique identifier for the packet
+const CanCreateRoomResultCode = 378
+
+// CanCreateRoomResultPacket notifies the player if more rooms can be created.
+type CanCreateRoomResultPacket struct {
+	Result int32 // Result is zero if allowed, or the Nitro reason of the rejection.
+	Limit  int32 // Limit is the maximum amount of rooms per player.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *CanCreateRoomResultPacket) Id() uint16 {
+	return CanCreateRoomResultCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *CanCreateRoomResultPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *CanCreateRoomResultPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *CanCreateRoomResultPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(CanCreateRoomResultCode)
+	pck.AddInt(p.Result)
+	pck.AddInt(p.Limit)
+	return pck
+}
@@ -0,0 +1,35 @@
+package creation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestCanCreateRoomResultPacket_Serialize verifies the packet serialization.
+func TestCanCreateRoomResultPacket_Serialize(t *testing.T) {
+	pck := &CanCreateRoomResultPacket{Result: int32(1), Limit: int32(2)}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(CanCreateRoomResultCode), raw.GetHeader())
+
+	r0, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), r0)
+
+	l1, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), l1)
+}
+
+// TestCanCreateRoomResultPacket_Integrity tests packet ID, deadline, and rate.
+func TestCanCreateRoomResultPacket_Integrity(t *testing.T) {
+	pck := &CanCreateRoomResultPacket{}
+	assert.Equal(t, uint16(CanCreateRoomResultCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,31 @@
+package creation
+
+import "pixels-emulator/core/protocol"
+
+// CanCreateRoomCode is the unique identifier for the packet
+const CanCreateRoomCode = 2128
+
+// CanCreateRoomPacket is sent by the client to check if the player can create more rooms.
+type CanCreateRoomPacket struct {
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *CanCreateRoomPacket) Id() uint16 {
+	return CanCreateRoomCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *CanCreateRoomPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *CanCreateRoomPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeCanCreateRoomPacket composes a new instance of the packet.
+func ComposeCanCreateRoomPacket(_ protocol.RawPacket) (*CanCreateRoomPacket, error) {
+	return &CanCreateRoomPacket{}, nil
+}
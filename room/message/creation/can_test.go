@@ -0,0 +1,25 @@
+package creation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeCanCreateRoomPacket verifies the packet is composed without content.
+func TestComposeCanCreateRoomPacket(t *testing.T) {
+	pck, err := ComposeCanCreateRoomPacket(protocol.NewPacket(CanCreateRoomCode))
+	assert.NoError(t, err)
+	assert.NotNil(t, pck)
+}
+
+// TestCanCreateRoomPacket_Integrity tests packet ID, deadline, and rate.
+func TestCanCreateRoomPacket_Integrity(t *testing.T) {
+	pck := &CanCreateRoomPacket{}
+	assert.Equal(t, uint16(CanCreateRoomCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,69 @@
+package creation
+
+import "pixels-emulator/core/protocol"
+
+// CreateRoomCode is the unique identifier for the packet
+const CreateRoomCode = 2752
+
+// CreateRoomPacket is sent by the client to create a new room owned by the player.
+type CreateRoomPacket struct {
+	Name        string // Name is the display name of the room.
+	Description string // Description is the textual description of the room.
+	Layout      string // Layout is the slug of the height map chosen.
+	Category    int32  // Category is the navigation category ID of the room.
+	UsersMax    int32  // UsersMax is the maximum number of users allowed in the room.
+	TradeMode   int32  // TradeMode is the trading mode. (0: Closed, 1: Rights only, 2: Open)
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *CreateRoomPacket) Id() uint16 {
+	return CreateRoomCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *CreateRoomPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *CreateRoomPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeCreateRoomPacket composes a new instance of the packet.
+func ComposeCreateRoomPacket(pck protocol.RawPacket) (*CreateRoomPacket, error) {
+
+	n, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateRoomPacket{Name: n, Description: d, Layout: l, Category: c, UsersMax: u, TradeMode: t}, nil
+
+}
@@ -0,0 +1,41 @@
+package creation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeCreateRoomPacket verifies the packet is composed from its content.
+func TestComposeCreateRoomPacket(t *testing.T) {
+	raw := protocol.NewPacket(CreateRoomCode)
+	raw.AddString("value1")
+	raw.AddString("value2")
+	raw.AddString("value3")
+	raw.AddInt(int32(4))
+	raw.AddInt(int32(5))
+	raw.AddInt(int32(6))
+
+	pck, err := ComposeCreateRoomPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, "value1", pck.Name)
+	assert.Equal(t, "value2", pck.Description)
+	assert.Equal(t, "value3", pck.Layout)
+	assert.Equal(t, int32(4), pck.Category)
+	assert.Equal(t, int32(5), pck.UsersMax)
+	assert.Equal(t, int32(6), pck.TradeMode)
+
+	_, err = ComposeCreateRoomPacket(protocol.NewPacket(CreateRoomCode))
+	assert.Error(t, err)
+}
+
+// TestCreateRoomPacket_Integrity tests packet ID, deadline, and rate.
+func TestCreateRoomPacket_Integrity(t *testing.T) {
+	pck := &CreateRoomPacket{}
+	assert.Equal(t, uint16(CreateRoomCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
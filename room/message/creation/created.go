@@ -0,0 +1,36 @@
+package creation
+
+import "pixels-emulator/core/protocol"
+
+// RoomCreatedCode is the unique identifier for the packet
+const RoomCreatedCode = 1304
+
+// RoomCreatedPacket notifies the player the room was created, so the client forwards the player into it.
+type RoomCreatedPacket struct {
+	Room int32  // Room is the identifier of the created room.
+	Name string // Name is the display name of the created room.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *RoomCreatedPacket) Id() uint16 {
+	return RoomCreatedCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *RoomCreatedPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *RoomCreatedPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *RoomCreatedPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(RoomCreatedCode)
+	pck.AddInt(p.Room)
+	pck.AddString(p.Name)
+	return pck
+}
@@ -0,0 +1,35 @@
+package creation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestRoomCreatedPacket_Serialize verifies the packet serialization.
+func TestRoomCreatedPacket_Serialize(t *testing.T) {
+	pck := &RoomCreatedPacket{Room: int32(1), Name: "value2"}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(RoomCreatedCode), raw.GetHeader())
+
+	r0, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), r0)
+
+	n1, err := raw.ReadString()
+	assert.NoError(t, err)
+	assert.Equal(t, "value2", n1)
+}
+
+// TestRoomCreatedPacket_Integrity tests packet ID, deadline, and rate.
+func TestRoomCreatedPacket_Integrity(t *testing.T) {
+	pck := &RoomCreatedPacket{}
+	assert.Equal(t, uint16(RoomCreatedCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
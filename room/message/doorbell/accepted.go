@@ -0,0 +1,35 @@
+package doorbell
+
+import "pixels-emulator/core/protocol"
+
+// DoorbellAcceptedCode is the unique identifier for the packet
+const DoorbellAcceptedCode = 3783
+
+// DoorbellAcceptedPacket notifies an accepted doorbell ring. Controllers receive the username
+// of the accepted player, while the ringer receives an empty username.
+type DoorbellAcceptedPacket struct {
+	Username string // Username is the name of the ringing player.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *DoorbellAcceptedPacket) Id() uint16 {
+	return DoorbellAcceptedCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *DoorbellAcceptedPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *DoorbellAcceptedPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *DoorbellAcceptedPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(DoorbellAcceptedCode)
+	pck.AddString(p.Username)
+	return pck
+}
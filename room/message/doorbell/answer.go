@@ -0,0 +1,45 @@
+package doorbell
+
+import "pixels-emulator/core/protocol"
+
+// AnswerCode is the unique identifier for the packet
+const AnswerCode = 1644
+
+// AnswerPacket is sent by a room controller to let in or deny a ringing player.
+type AnswerPacket struct {
+	Username string // Username is the name of the ringing player.
+	Accept   bool   // Accept defines if the player is let in.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *AnswerPacket) Id() uint16 {
+	return AnswerCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *AnswerPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *AnswerPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeAnswerPacket composes a new instance of the packet.
+func ComposeAnswerPacket(pck protocol.RawPacket) (*AnswerPacket, error) {
+
+	u, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := pck.ReadBoolean()
+	if err != nil {
+		return nil, err
+	}
+
+	return &AnswerPacket{Username: u, Accept: a}, nil
+
+}
@@ -0,0 +1,33 @@
+package doorbell
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeAnswerPacket verifies the packet is composed from its content.
+func TestComposeAnswerPacket(t *testing.T) {
+	raw := protocol.NewPacket(AnswerCode)
+	raw.AddString("john")
+	raw.AddBoolean(true)
+
+	pck, err := ComposeAnswerPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, "john", pck.Username)
+	assert.True(t, pck.Accept)
+
+	_, err = ComposeAnswerPacket(protocol.NewPacket(AnswerCode))
+	assert.Error(t, err)
+}
+
+// TestAnswerPacket_Integrity tests packet ID, deadline, and rate.
+func TestAnswerPacket_Integrity(t *testing.T) {
+	pck := &AnswerPacket{}
+	assert.Equal(t, uint16(AnswerCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,35 @@
+package doorbell
+
+import "pixels-emulator/core/protocol"
+
+// DoorbellRejectedCode is the unique identifier for the packet
+const DoorbellRejectedCode = 878
+
+// DoorbellRejectedPacket notifies a rejected or unanswered doorbell ring. Controllers receive the
+// username of the rejected player, while the ringer receives an empty username.
+type DoorbellRejectedPacket struct {
+	Username string // Username is the name of the ringing player.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *DoorbellRejectedPacket) Id() uint16 {
+	return DoorbellRejectedCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *DoorbellRejectedPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *DoorbellRejectedPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *DoorbellRejectedPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(DoorbellRejectedCode)
+	pck.AddString(p.Username)
+	return pck
+}
@@ -0,0 +1,31 @@
+package doorbell
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestDoorbellRejectedPacket_Serialize verifies the packet serialization.
+func TestDoorbellRejectedPacket_Serialize(t *testing.T) {
+	pck := &DoorbellRejectedPacket{Username: "john"}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(DoorbellRejectedCode), raw.GetHeader())
+
+	u, err := raw.ReadString()
+	assert.NoError(t, err)
+	assert.Equal(t, "john", u)
+}
+
+// TestDoorbellRejectedPacket_Integrity tests packet ID, deadline, and rate.
+func TestDoorbellRejectedPacket_Integrity(t *testing.T) {
+	pck := &DoorbellRejectedPacket{}
+	assert.Equal(t, uint16(DoorbellRejectedCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
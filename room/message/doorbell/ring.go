@@ -0,0 +1,35 @@
+package doorbell
+
+import "pixels-emulator/core/protocol"
+
+// DoorbellCode is the unique identifier for the packet
+const DoorbellCode = 2309
+
+// DoorbellPacket notifies a doorbell ring. Controllers receive the username of the
+// ringing player, while the ringer receives an empty username to await the answer.
+type DoorbellPacket struct {
+	Username string // Username is the name of the ringing player.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *DoorbellPacket) Id() uint16 {
+	return DoorbellCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *DoorbellPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *DoorbellPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *DoorbellPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(DoorbellCode)
+	pck.AddString(p.Username)
+	return pck
+}
@@ -0,0 +1,31 @@
+package doorbell
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestDoorbellPacket_Serialize verifies the packet serialization.
+func TestDoorbellPacket_Serialize(t *testing.T) {
+	pck := &DoorbellPacket{Username: "john"}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(DoorbellCode), raw.GetHeader())
+
+	u, err := raw.ReadString()
+	assert.NoError(t, err)
+	assert.Equal(t, "john", u)
+}
+
+// TestDoorbellPacket_Integrity tests packet ID, deadline, and rate.
+func TestDoorbellPacket_Integrity(t *testing.T) {
+	pck := &DoorbellPacket{}
+	assert.Equal(t, uint16(DoorbellCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
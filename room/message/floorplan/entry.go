@@ -0,0 +1,38 @@
+package floorplan
+
+import "pixels-emulator/core/protocol"
+
+// EntryTileCode is the unique identifier for the packet
+const EntryTileCode = 1664
+
+// EntryTilePacket provides the door position of the room to the floor plan editor.
+type EntryTilePacket struct {
+	X         int32 // X is the horizontal coordinate of the door.
+	Y         int32 // Y is the vertical coordinate of the door.
+	Direction int32 // Direction is the rotation of the door.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *EntryTilePacket) Id() uint16 {
+	return EntryTileCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *EntryTilePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *EntryTilePacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *EntryTilePacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(EntryTileCode)
+	pck.AddInt(p.X)
+	pck.AddInt(p.Y)
+	pck.AddInt(p.Direction)
+	return pck
+}
@@ -0,0 +1,39 @@
+package floorplan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestEntryTilePacket_Serialize verifies the packet serialization.
+func TestEntryTilePacket_Serialize(t *testing.T) {
+	pck := &EntryTilePacket{X: int32(1), Y: int32(2), Direction: int32(3)}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(EntryTileCode), raw.GetHeader())
+
+	x0, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), x0)
+
+	y1, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), y1)
+
+	d2, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), d2)
+}
+
+// TestEntryTilePacket_Integrity tests packet ID, deadline, and rate.
+func TestEntryTilePacket_Integrity(t *testing.T) {
+	pck := &EntryTilePacket{}
+	assert.Equal(t, uint16(EntryTileCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,31 @@
+package floorplan
+
+import "pixels-emulator/core/protocol"
+
+// GetEntryTileCode is the unique identifier for the packet
+const GetEntryTileCode = 3559
+
+// GetEntryTilePacket requests the door position of the room for the floor plan editor.
+type GetEntryTilePacket struct {
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *GetEntryTilePacket) Id() uint16 {
+	return GetEntryTileCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *GetEntryTilePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *GetEntryTilePacket) Deadline() uint {
+	return 500
+}
+
+// ComposeGetEntryTilePacket composes a new instance of the packet.
+func ComposeGetEntryTilePacket(_ protocol.RawPacket) (*GetEntryTilePacket, error) {
+	return &GetEntryTilePacket{}, nil
+}
@@ -0,0 +1,25 @@
+package floorplan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeGetEntryTilePacket verifies the packet is composed without content.
+func TestComposeGetEntryTilePacket(t *testing.T) {
+	pck, err := ComposeGetEntryTilePacket(protocol.NewPacket(GetEntryTileCode))
+	assert.NoError(t, err)
+	assert.NotNil(t, pck)
+}
+
+// TestGetEntryTilePacket_Integrity tests packet ID, deadline, and rate.
+func TestGetEntryTilePacket_Integrity(t *testing.T) {
+	pck := &GetEntryTilePacket{}
+	assert.Equal(t, uint16(GetEntryTileCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
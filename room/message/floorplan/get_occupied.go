@@ -0,0 +1,31 @@
+package floorplan
+
+import "pixels-emulator/core/protocol"
+
+// GetOccupiedTilesCode is the unique identifier for the packet
+const GetOccupiedTilesCode = 1687
+
+// GetOccupiedTilesPacket requests the tiles which can not be removed by the floor plan editor.
+type GetOccupiedTilesPacket struct {
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *GetOccupiedTilesPacket) Id() uint16 {
+	return GetOccupiedTilesCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *GetOccupiedTilesPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *GetOccupiedTilesPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeGetOccupiedTilesPacket composes a new instance of the packet.
+func ComposeGetOccupiedTilesPacket(_ protocol.RawPacket) (*GetOccupiedTilesPacket, error) {
+	return &GetOccupiedTilesPacket{}, nil
+}
@@ -0,0 +1,25 @@
+package floorplan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeGetOccupiedTilesPacket verifies the packet is composed without content.
+func TestComposeGetOccupiedTilesPacket(t *testing.T) {
+	pck, err := ComposeGetOccupiedTilesPacket(protocol.NewPacket(GetOccupiedTilesCode))
+	assert.NoError(t, err)
+	assert.NotNil(t, pck)
+}
+
+// TestGetOccupiedTilesPacket_Integrity tests packet ID, deadline, and rate.
+func TestGetOccupiedTilesPacket_Integrity(t *testing.T) {
+	pck := &GetOccupiedTilesPacket{}
+	assert.Equal(t, uint16(GetOccupiedTilesCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,44 @@
+package floorplan
+
+import "pixels-emulator/core/protocol"
+
+// OccupiedTilesCode is the unique identifier for the packet
+const OccupiedTilesCode = 3990
+
+// Tile defines a position of the heightmap.
+type Tile struct {
+	X int32 // X is the horizontal coordinate of the tile.
+	Y int32 // Y is the vertical coordinate of the tile.
+}
+
+// OccupiedTilesPacket sends the tiles which can not be removed by the floor plan editor.
+type OccupiedTilesPacket struct {
+	Tiles []Tile // Tiles are the occupied positions of the room.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *OccupiedTilesPacket) Id() uint16 {
+	return OccupiedTilesCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *OccupiedTilesPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *OccupiedTilesPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *OccupiedTilesPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(OccupiedTilesCode)
+	pck.AddInt(int32(len(p.Tiles)))
+	for _, t := range p.Tiles {
+		pck.AddInt(t.X)
+		pck.AddInt(t.Y)
+	}
+	return pck
+}
@@ -0,0 +1,37 @@
+package floorplan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestOccupiedTilesPacket_Serialize verifies the packet serialization.
+func TestOccupiedTilesPacket_Serialize(t *testing.T) {
+	pck := &OccupiedTilesPacket{Tiles: []Tile{{X: 1, Y: 2}, {X: 3, Y: 4}}}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	n, _ := raw.ReadInt()
+	assert.Equal(t, int32(2), n)
+
+	for _, tile := range pck.Tiles {
+		x, _ := raw.ReadInt()
+		y, err := raw.ReadInt()
+		assert.NoError(t, err)
+		assert.Equal(t, tile.X, x)
+		assert.Equal(t, tile.Y, y)
+	}
+}
+
+// TestOccupiedTilesPacket_Integrity tests packet ID, deadline, and rate.
+func TestOccupiedTilesPacket_Integrity(t *testing.T) {
+	pck := &OccupiedTilesPacket{}
+	assert.Equal(t, uint16(OccupiedTilesCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,75 @@
+package floorplan
+
+import "pixels-emulator/core/protocol"
+
+// SaveFloorPlanCode is the unique identifier for the packet
+const SaveFloorPlanCode = 875
+
+// SaveFloorPlanPacket saves the custom heightmap drawn with the floor plan editor.
+type SaveFloorPlanPacket struct {
+	Map            string // Map is the heightmap with rows separated by carriage returns.
+	DoorX          int32  // DoorX is the horizontal coordinate of the door.
+	DoorY          int32  // DoorY is the vertical coordinate of the door.
+	DoorDirection  int32  // DoorDirection is the rotation of the door.
+	WallThickness  int32  // WallThickness is the thickness of the walls.
+	FloorThickness int32  // FloorThickness is the thickness of the floor.
+	WallHeight     int32  // WallHeight is the height of the walls, -1 for automatic.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *SaveFloorPlanPacket) Id() uint16 {
+	return SaveFloorPlanCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *SaveFloorPlanPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *SaveFloorPlanPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeSaveFloorPlanPacket composes a new instance of the packet.
+func ComposeSaveFloorPlanPacket(pck protocol.RawPacket) (*SaveFloorPlanPacket, error) {
+
+	m, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	do, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	dox, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	wa, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &SaveFloorPlanPacket{Map: m, DoorX: d, DoorY: do, DoorDirection: dox, WallThickness: w, FloorThickness: f, WallHeight: wa}, nil
+
+}
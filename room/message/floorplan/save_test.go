@@ -0,0 +1,43 @@
+package floorplan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeSaveFloorPlanPacket verifies the packet is composed from its content.
+func TestComposeSaveFloorPlanPacket(t *testing.T) {
+	raw := protocol.NewPacket(SaveFloorPlanCode)
+	raw.AddString("value1")
+	raw.AddInt(int32(2))
+	raw.AddInt(int32(3))
+	raw.AddInt(int32(4))
+	raw.AddInt(int32(5))
+	raw.AddInt(int32(6))
+	raw.AddInt(int32(7))
+
+	pck, err := ComposeSaveFloorPlanPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, "value1", pck.Map)
+	assert.Equal(t, int32(2), pck.DoorX)
+	assert.Equal(t, int32(3), pck.DoorY)
+	assert.Equal(t, int32(4), pck.DoorDirection)
+	assert.Equal(t, int32(5), pck.WallThickness)
+	assert.Equal(t, int32(6), pck.FloorThickness)
+	assert.Equal(t, int32(7), pck.WallHeight)
+
+	_, err = ComposeSaveFloorPlanPacket(protocol.NewPacket(SaveFloorPlanCode))
+	assert.Error(t, err)
+}
+
+// TestSaveFloorPlanPacket_Integrity tests packet ID, deadline, and rate.
+func TestSaveFloorPlanPacket_Integrity(t *testing.T) {
+	pck := &SaveFloorPlanPacket{}
+	assert.Equal(t, uint16(SaveFloorPlanCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
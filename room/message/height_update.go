@@ -0,0 +1,45 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// HeightMapUpdateCode is the unique identifier for the packet
+const HeightMapUpdateCode = 558
+
+// TileHeight defines the relative height of a single tile.
+type TileHeight struct {
+	X, Y   byte  // X, Y are the coordinates of the tile.
+	Height int16 // Height is the relative height of the tile, as sent in the heightmap.
+}
+
+// HeightMapUpdatePacket provides the heights of the tiles changed since the heightmap was sent.
+type HeightMapUpdatePacket struct {
+	Tiles []TileHeight // Tiles are the tiles updated.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *HeightMapUpdatePacket) Id() uint16 {
+	return HeightMapUpdateCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *HeightMapUpdatePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *HeightMapUpdatePacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte. Nitro reads the amount of tiles as a single byte.
+func (p *HeightMapUpdatePacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(HeightMapUpdateCode)
+	pck.AddByte(byte(len(p.Tiles)))
+	for _, t := range p.Tiles {
+		pck.AddByte(t.X)
+		pck.AddByte(t.Y)
+		pck.AddShort(t.Height)
+	}
+	return pck
+}
@@ -0,0 +1,36 @@
+package message
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"testing"
+)
+
+// TestHeightMapUpdatePacket_Serialize verifies the packet serialization.
+func TestHeightMapUpdatePacket_Serialize(t *testing.T) {
+	pck := &HeightMapUpdatePacket{Tiles: []TileHeight{{X: 1, Y: 2, Height: 256}, {X: 3, Y: 4, Height: 64 * 256}}}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	n, _ := raw.ReadByte()
+	assert.Equal(t, byte(2), n)
+
+	for _, tile := range pck.Tiles {
+		x, _ := raw.ReadByte()
+		y, _ := raw.ReadByte()
+		h, err := raw.ReadShort()
+		assert.NoError(t, err)
+		assert.Equal(t, tile, TileHeight{X: x, Y: y, Height: h})
+	}
+}
+
+// TestHeightMapUpdatePacket_Integrity tests packet ID, deadline, and rate.
+func TestHeightMapUpdatePacket_Integrity(t *testing.T) {
+	pck := &HeightMapUpdatePacket{}
+	assert.Equal(t, uint16(HeightMapUpdateCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
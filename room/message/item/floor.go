@@ -0,0 +1,57 @@
+package item
+
+import (
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+)
+
+// FloorItemsCode is the unique identifier for the packet
+const FloorItemsCode = 1778
+
+// Owner defines the name of a user owning items of the room.
+type Owner struct {
+	UserId int32  // UserId is the identifier of the owner.
+	Name   string // Name is the username of the owner.
+}
+
+// FloorItemsPacket provides the items placed on the room tiles along their owners.
+type FloorItemsPacket struct {
+	Owners []Owner            // Owners are the users owning the items.
+	Items  []encode.FloorItem // Items are the floor items of the room.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *FloorItemsPacket) Id() uint16 {
+	return FloorItemsCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *FloorItemsPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *FloorItemsPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *FloorItemsPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(FloorItemsCode)
+	encodeOwners(&pck, p.Owners)
+	pck.AddInt(int32(len(p.Items)))
+	for _, i := range p.Items {
+		i.Encode(&pck)
+	}
+	return pck
+}
+
+// encodeOwners adds the owners of the items to a packet.
+func encodeOwners(pck *protocol.RawPacket, owners []Owner) {
+	pck.AddInt(int32(len(owners)))
+	for _, o := range owners {
+		pck.AddInt(o.UserId)
+		pck.AddString(o.Name)
+	}
+}
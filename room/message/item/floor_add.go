@@ -0,0 +1,39 @@
+package item
+
+import (
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+)
+
+// FloorItemAddCode is the unique identifier for the packet
+const FloorItemAddCode = 1534
+
+// FloorItemAddPacket notifies a floor item placed in the room.
+type FloorItemAddPacket struct {
+	Item      encode.FloorItem // Item is the placed item.
+	OwnerName string           // OwnerName is the username of the item owner.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *FloorItemAddPacket) Id() uint16 {
+	return FloorItemAddCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *FloorItemAddPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *FloorItemAddPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *FloorItemAddPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(FloorItemAddCode)
+	p.Item.Encode(&pck)
+	pck.AddString(p.OwnerName)
+	return pck
+}
@@ -0,0 +1,35 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+)
+
+// TestFloorItemAddPacket_Serialize verifies the packet serialization.
+func TestFloorItemAddPacket_Serialize(t *testing.T) {
+	pck := &FloorItemAddPacket{Item: encode.FloorItem{ItemId: 3, Sprite: 13, X: 1, Y: 2, Z: 0.5, StackHeight: 1, OwnerId: 1}, OwnerName: "john"}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	item := encode.FloorItem{}
+	assert.NoError(t, item.Decode(raw))
+	assert.Equal(t, pck.Item, item)
+
+	name, err := raw.ReadString()
+	assert.NoError(t, err)
+	assert.Equal(t, "john", name)
+}
+
+// TestFloorItemAddPacket_Integrity tests packet ID, deadline, and rate.
+func TestFloorItemAddPacket_Integrity(t *testing.T) {
+	pck := &FloorItemAddPacket{}
+	assert.Equal(t, uint16(FloorItemAddCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
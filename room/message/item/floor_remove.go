@@ -0,0 +1,43 @@
+package item
+
+import (
+	"pixels-emulator/core/protocol"
+	"strconv"
+)
+
+// FloorItemRemoveCode is the unique identifier for the packet
+const FloorItemRemoveCode = 2703
+
+// FloorItemRemovePacket notifies a floor item removed from the room.
+type FloorItemRemovePacket struct {
+	ItemId  int32 // ItemId is the identifier of the removed item.
+	Expired bool  // Expired defines if the item was removed by its expiration.
+	Picker  int32 // Picker is the identifier of the user who picked up the item.
+	Delay   int32 // Delay are the milliseconds until the item disappears.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *FloorItemRemovePacket) Id() uint16 {
+	return FloorItemRemoveCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *FloorItemRemovePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *FloorItemRemovePacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *FloorItemRemovePacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(FloorItemRemoveCode)
+	pck.AddString(strconv.Itoa(int(p.ItemId)))
+	pck.AddBoolean(p.Expired)
+	pck.AddInt(p.Picker)
+	pck.AddInt(p.Delay)
+	return pck
+}
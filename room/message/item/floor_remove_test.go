@@ -0,0 +1,36 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestFloorItemRemovePacket_Serialize verifies the packet serialization.
+func TestFloorItemRemovePacket_Serialize(t *testing.T) {
+	pck := &FloorItemRemovePacket{ItemId: 3, Picker: 1}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	id, _ := raw.ReadString()
+	expired, _ := raw.ReadBoolean()
+	picker, _ := raw.ReadInt()
+	delay, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, "3", id)
+	assert.False(t, expired)
+	assert.Equal(t, int32(1), picker)
+	assert.Equal(t, int32(0), delay)
+}
+
+// TestFloorItemRemovePacket_Integrity tests packet ID, deadline, and rate.
+func TestFloorItemRemovePacket_Integrity(t *testing.T) {
+	pck := &FloorItemRemovePacket{}
+	assert.Equal(t, uint16(FloorItemRemoveCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,43 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+)
+
+// TestFloorItemsPacket_Serialize verifies the packet serialization.
+func TestFloorItemsPacket_Serialize(t *testing.T) {
+	pck := &FloorItemsPacket{
+		Owners: []Owner{{UserId: 1, Name: "john"}},
+		Items:  []encode.FloorItem{{ItemId: 3, Sprite: 13, X: 1, Y: 2, Z: 0.5, StackHeight: 1, OwnerId: 1}},
+	}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	n, _ := raw.ReadInt()
+	assert.Equal(t, int32(1), n)
+	id, _ := raw.ReadInt()
+	name, _ := raw.ReadString()
+	assert.Equal(t, int32(1), id)
+	assert.Equal(t, "john", name)
+
+	n, _ = raw.ReadInt()
+	assert.Equal(t, int32(1), n)
+	item := encode.FloorItem{}
+	assert.NoError(t, item.Decode(raw))
+	assert.Equal(t, pck.Items[0], item)
+}
+
+// TestFloorItemsPacket_Integrity tests packet ID, deadline, and rate.
+func TestFloorItemsPacket_Integrity(t *testing.T) {
+	pck := &FloorItemsPacket{}
+	assert.Equal(t, uint16(FloorItemsCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
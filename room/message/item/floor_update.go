@@ -0,0 +1,37 @@
+package item
+
+import (
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+)
+
+// FloorItemUpdateCode is the unique identifier for the packet
+const FloorItemUpdateCode = 3776
+
+// FloorItemUpdatePacket notifies a floor item moved, rotated or changed in the room.
+type FloorItemUpdatePacket struct {
+	Item encode.FloorItem // Item is the updated item.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *FloorItemUpdatePacket) Id() uint16 {
+	return FloorItemUpdateCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *FloorItemUpdatePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *FloorItemUpdatePacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *FloorItemUpdatePacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(FloorItemUpdateCode)
+	p.Item.Encode(&pck)
+	return pck
+}
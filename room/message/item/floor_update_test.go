@@ -0,0 +1,31 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+)
+
+// TestFloorItemUpdatePacket_Serialize verifies the packet serialization.
+func TestFloorItemUpdatePacket_Serialize(t *testing.T) {
+	pck := &FloorItemUpdatePacket{Item: encode.FloorItem{ItemId: 3, Sprite: 13, X: 1, Y: 2, Rotation: 4, OwnerId: 1}}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	item := encode.FloorItem{}
+	assert.NoError(t, item.Decode(raw))
+	assert.Equal(t, pck.Item, item)
+}
+
+// TestFloorItemUpdatePacket_Integrity tests packet ID, deadline, and rate.
+func TestFloorItemUpdatePacket_Integrity(t *testing.T) {
+	pck := &FloorItemUpdatePacket{}
+	assert.Equal(t, uint16(FloorItemUpdateCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,57 @@
+package item
+
+import "pixels-emulator/core/protocol"
+
+// MoveFloorItemCode is the unique identifier for the packet
+const MoveFloorItemCode = 248
+
+// MoveFloorItemPacket represents a packet sent by client to move or rotate a floor item of the room.
+type MoveFloorItemPacket struct {
+	Item     int32 // Item is the identifier of the item moved.
+	X        int32 // X is the new coordinate along the X axis.
+	Y        int32 // Y is the new coordinate along the Y axis.
+	Rotation int32 // Rotation is the new direction of the item.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *MoveFloorItemPacket) Id() uint16 {
+	return MoveFloorItemCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *MoveFloorItemPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *MoveFloorItemPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeMoveFloorItemPacket composes a new instance of the packet.
+func ComposeMoveFloorItemPacket(pck protocol.RawPacket) (*MoveFloorItemPacket, error) {
+
+	i, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	x, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &MoveFloorItemPacket{Item: i, X: x, Y: y, Rotation: r}, nil
+
+}
@@ -0,0 +1,37 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeMoveFloorItemPacket verifies the packet is composed from its content.
+func TestComposeMoveFloorItemPacket(t *testing.T) {
+	raw := protocol.NewPacket(MoveFloorItemCode)
+	raw.AddInt(int32(1))
+	raw.AddInt(int32(2))
+	raw.AddInt(int32(3))
+	raw.AddInt(int32(4))
+
+	pck, err := ComposeMoveFloorItemPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.Item)
+	assert.Equal(t, int32(2), pck.X)
+	assert.Equal(t, int32(3), pck.Y)
+	assert.Equal(t, int32(4), pck.Rotation)
+
+	_, err = ComposeMoveFloorItemPacket(protocol.NewPacket(MoveFloorItemCode))
+	assert.Error(t, err)
+}
+
+// TestMoveFloorItemPacket_Integrity tests packet ID, deadline, and rate.
+func TestMoveFloorItemPacket_Integrity(t *testing.T) {
+	pck := &MoveFloorItemPacket{}
+	assert.Equal(t, uint16(MoveFloorItemCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
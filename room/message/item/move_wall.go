@@ -0,0 +1,45 @@
+package item
+
+import "pixels-emulator/core/protocol"
+
+// MoveWallItemCode is the unique identifier for the packet
+const MoveWallItemCode = 168
+
+// MoveWallItemPacket represents a packet sent by client to move a wall item of the room.
+type MoveWallItemPacket struct {
+	Item     int32  // Item is the identifier of the item moved.
+	Position string // Position is the new location of the item on the wall.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *MoveWallItemPacket) Id() uint16 {
+	return MoveWallItemCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *MoveWallItemPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *MoveWallItemPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeMoveWallItemPacket composes a new instance of the packet.
+func ComposeMoveWallItemPacket(pck protocol.RawPacket) (*MoveWallItemPacket, error) {
+
+	i, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	po, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	return &MoveWallItemPacket{Item: i, Position: po}, nil
+
+}
@@ -0,0 +1,33 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeMoveWallItemPacket verifies the packet is composed from its content.
+func TestComposeMoveWallItemPacket(t *testing.T) {
+	raw := protocol.NewPacket(MoveWallItemCode)
+	raw.AddInt(int32(1))
+	raw.AddString("value2")
+
+	pck, err := ComposeMoveWallItemPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.Item)
+	assert.Equal(t, "value2", pck.Position)
+
+	_, err = ComposeMoveWallItemPacket(protocol.NewPacket(MoveWallItemCode))
+	assert.Error(t, err)
+}
+
+// TestMoveWallItemPacket_Integrity tests packet ID, deadline, and rate.
+func TestMoveWallItemPacket_Integrity(t *testing.T) {
+	pck := &MoveWallItemPacket{}
+	assert.Equal(t, uint16(MoveWallItemCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,50 @@
+package item
+
+import "pixels-emulator/core/protocol"
+
+// PickupItemCode is the unique identifier for the packet
+const PickupItemCode = 3456
+
+const (
+	FloorCategory int32 = 10 // FloorCategory defines the items placed on the floor.
+	WallCategory  int32 = 20 // WallCategory defines the items placed on the walls.
+)
+
+// PickupItemPacket represents a packet sent by client to pick up an item of the room.
+type PickupItemPacket struct {
+	Category int32 // Category defines if the item is placed on the floor or on the walls.
+	Item     int32 // Item is the identifier of the item picked up.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *PickupItemPacket) Id() uint16 {
+	return PickupItemCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *PickupItemPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *PickupItemPacket) Deadline() uint {
+	return 500
+}
+
+// ComposePickupItemPacket composes a new instance of the packet.
+func ComposePickupItemPacket(pck protocol.RawPacket) (*PickupItemPacket, error) {
+
+	c, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	i, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &PickupItemPacket{Category: c, Item: i}, nil
+
+}
@@ -0,0 +1,33 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposePickupItemPacket verifies the packet is composed from its content.
+func TestComposePickupItemPacket(t *testing.T) {
+	raw := protocol.NewPacket(PickupItemCode)
+	raw.AddInt(int32(1))
+	raw.AddInt(int32(2))
+
+	pck, err := ComposePickupItemPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.Category)
+	assert.Equal(t, int32(2), pck.Item)
+
+	_, err = ComposePickupItemPacket(protocol.NewPacket(PickupItemCode))
+	assert.Error(t, err)
+}
+
+// TestPickupItemPacket_Integrity tests packet ID, deadline, and rate.
+func TestPickupItemPacket_Integrity(t *testing.T) {
+	pck := &PickupItemPacket{}
+	assert.Equal(t, uint16(PickupItemCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,82 @@
+package item
+
+import (
+	"errors"
+	"pixels-emulator/core/protocol"
+	"strconv"
+	"strings"
+)
+
+// PlaceItemCode is the unique identifier for the packet
+const PlaceItemCode = 1258
+
+// PlaceItemPacket represents a packet sent by client to place an owned item in the room.
+// Nitro sends every value in a single text, with the wall position for wall items
+// or the coordinates and rotation for floor items.
+type PlaceItemPacket struct {
+	Item     int32  // Item is the identifier of the item placed.
+	Wall     bool   // Wall defines if the item is placed on the walls.
+	Position string // Position is the location on the wall of wall items.
+	X        int32  // X is the coordinate along the X axis of floor items.
+	Y        int32  // Y is the coordinate along the Y axis of floor items.
+	Rotation int32  // Rotation is the direction of floor items.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *PlaceItemPacket) Id() uint16 {
+	return PlaceItemCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *PlaceItemPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *PlaceItemPacket) Deadline() uint {
+	return 500
+}
+
+// ComposePlaceItemPacket composes a new instance of the packet.
+func ComposePlaceItemPacket(pck protocol.RawPacket) (*PlaceItemPacket, error) {
+
+	data, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.Fields(data)
+	if len(parts) < 2 {
+		return nil, errors.New("invalid item placement data")
+	}
+
+	id, err := strconv.ParseInt(parts[0], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	p := &PlaceItemPacket{Item: int32(id)}
+
+	// Wall positions start by their wall coordinates, as ":w=0,2 l=11,31 r".
+	if strings.HasPrefix(parts[1], ":") {
+		p.Wall = true
+		p.Position = strings.Join(parts[1:], " ")
+		return p, nil
+	}
+
+	if len(parts) < 4 {
+		return nil, errors.New("invalid floor item placement data")
+	}
+
+	values := []*int32{&p.X, &p.Y, &p.Rotation}
+	for i, v := range values {
+		n, err := strconv.ParseInt(parts[i+1], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		*v = int32(n)
+	}
+
+	return p, nil
+
+}
@@ -0,0 +1,48 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// place creates a raw placement packet with the Nitro data.
+func place(data string) protocol.RawPacket {
+	pck := protocol.NewPacket(PlaceItemCode)
+	pck.AddString(data)
+	return pck
+}
+
+// TestComposePlaceItemPacket verifies floor and wall placements are parsed.
+func TestComposePlaceItemPacket(t *testing.T) {
+	pck, err := ComposePlaceItemPacket(place("12 3 4 2"))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(12), pck.Item)
+	assert.False(t, pck.Wall)
+	assert.Equal(t, int32(3), pck.X)
+	assert.Equal(t, int32(4), pck.Y)
+	assert.Equal(t, int32(2), pck.Rotation)
+
+	pck, err = ComposePlaceItemPacket(place("7 :w=0,2 l=11,31 r "))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(7), pck.Item)
+	assert.True(t, pck.Wall)
+	assert.Equal(t, ":w=0,2 l=11,31 r", pck.Position)
+
+	_, err = ComposePlaceItemPacket(place("7 3"))
+	assert.Error(t, err)
+
+	_, err = ComposePlaceItemPacket(place("seven 3 4 2"))
+	assert.Error(t, err)
+}
+
+// TestPlaceItemPacket_Integrity tests packet ID, deadline, and rate.
+func TestPlaceItemPacket_Integrity(t *testing.T) {
+	pck := &PlaceItemPacket{}
+	assert.Equal(t, uint16(PlaceItemCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,42 @@
+package item
+
+import (
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+)
+
+// WallItemsCode is the unique identifier for the packet
+const WallItemsCode = 1369
+
+// WallItemsPacket provides the items placed on the room walls along their owners.
+type WallItemsPacket struct {
+	Owners []Owner           // Owners are the users owning the items.
+	Items  []encode.WallItem // Items are the wall items of the room.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *WallItemsPacket) Id() uint16 {
+	return WallItemsCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *WallItemsPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *WallItemsPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *WallItemsPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(WallItemsCode)
+	encodeOwners(&pck, p.Owners)
+	pck.AddInt(int32(len(p.Items)))
+	for _, i := range p.Items {
+		i.Encode(&pck)
+	}
+	return pck
+}
@@ -0,0 +1,39 @@
+package item
+
+import (
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+)
+
+// WallItemAddCode is the unique identifier for the packet
+const WallItemAddCode = 2187
+
+// WallItemAddPacket notifies a wall item placed in the room.
+type WallItemAddPacket struct {
+	Item      encode.WallItem // Item is the placed item.
+	OwnerName string          // OwnerName is the username of the item owner.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *WallItemAddPacket) Id() uint16 {
+	return WallItemAddCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *WallItemAddPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *WallItemAddPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *WallItemAddPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(WallItemAddCode)
+	p.Item.Encode(&pck)
+	pck.AddString(p.OwnerName)
+	return pck
+}
@@ -0,0 +1,35 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+)
+
+// TestWallItemAddPacket_Serialize verifies the packet serialization.
+func TestWallItemAddPacket_Serialize(t *testing.T) {
+	pck := &WallItemAddPacket{Item: encode.WallItem{ItemId: 4, Sprite: 4001, Position: ":w=0,2 l=11,31 r", OwnerId: 1}, OwnerName: "john"}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	item := encode.WallItem{}
+	assert.NoError(t, item.Decode(raw))
+	assert.Equal(t, pck.Item, item)
+
+	name, err := raw.ReadString()
+	assert.NoError(t, err)
+	assert.Equal(t, "john", name)
+}
+
+// TestWallItemAddPacket_Integrity tests packet ID, deadline, and rate.
+func TestWallItemAddPacket_Integrity(t *testing.T) {
+	pck := &WallItemAddPacket{}
+	assert.Equal(t, uint16(WallItemAddCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,39 @@
+package item
+
+import (
+	"pixels-emulator/core/protocol"
+	"strconv"
+)
+
+// WallItemRemoveCode is the unique identifier for the packet
+const WallItemRemoveCode = 3208
+
+// WallItemRemovePacket notifies a wall item removed from the room.
+type WallItemRemovePacket struct {
+	ItemId int32 // ItemId is the identifier of the removed item.
+	Picker int32 // Picker is the identifier of the user who picked up the item.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *WallItemRemovePacket) Id() uint16 {
+	return WallItemRemoveCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *WallItemRemovePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *WallItemRemovePacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *WallItemRemovePacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(WallItemRemoveCode)
+	pck.AddString(strconv.Itoa(int(p.ItemId)))
+	pck.AddInt(p.Picker)
+	return pck
+}
@@ -0,0 +1,32 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestWallItemRemovePacket_Serialize verifies the packet serialization.
+func TestWallItemRemovePacket_Serialize(t *testing.T) {
+	pck := &WallItemRemovePacket{ItemId: 4, Picker: 1}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	id, _ := raw.ReadString()
+	picker, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, "4", id)
+	assert.Equal(t, int32(1), picker)
+}
+
+// TestWallItemRemovePacket_Integrity tests packet ID, deadline, and rate.
+func TestWallItemRemovePacket_Integrity(t *testing.T) {
+	pck := &WallItemRemovePacket{}
+	assert.Equal(t, uint16(WallItemRemoveCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
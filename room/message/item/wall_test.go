@@ -0,0 +1,41 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+)
+
+// TestWallItemsPacket_Serialize verifies the packet serialization.
+func TestWallItemsPacket_Serialize(t *testing.T) {
+	pck := &WallItemsPacket{
+		Owners: []Owner{{UserId: 1, Name: "john"}},
+		Items:  []encode.WallItem{{ItemId: 4, Sprite: 4001, Position: ":w=0,2 l=11,31 r", OwnerId: 1}},
+	}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	n, _ := raw.ReadInt()
+	assert.Equal(t, int32(1), n)
+	_, _ = raw.ReadInt()
+	_, _ = raw.ReadString()
+
+	n, _ = raw.ReadInt()
+	assert.Equal(t, int32(1), n)
+	item := encode.WallItem{}
+	assert.NoError(t, item.Decode(raw))
+	assert.Equal(t, pck.Items[0], item)
+}
+
+// TestWallItemsPacket_Integrity tests packet ID, deadline, and rate.
+func TestWallItemsPacket_Integrity(t *testing.T) {
+	pck := &WallItemsPacket{}
+	assert.Equal(t, uint16(WallItemsCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,37 @@
+package item
+
+import (
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+)
+
+// WallItemUpdateCode is the unique identifier for the packet
+const WallItemUpdateCode = 2009
+
+// WallItemUpdatePacket notifies a wall item moved or changed in the room.
+type WallItemUpdatePacket struct {
+	Item encode.WallItem // Item is the updated item.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *WallItemUpdatePacket) Id() uint16 {
+	return WallItemUpdateCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *WallItemUpdatePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *WallItemUpdatePacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *WallItemUpdatePacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(WallItemUpdateCode)
+	p.Item.Encode(&pck)
+	return pck
+}
@@ -0,0 +1,31 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+)
+
+// TestWallItemUpdatePacket_Serialize verifies the packet serialization.
+func TestWallItemUpdatePacket_Serialize(t *testing.T) {
+	pck := &WallItemUpdatePacket{Item: encode.WallItem{ItemId: 4, Sprite: 4001, Position: ":w=1,2 l=5,31 l", OwnerId: 1}}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	item := encode.WallItem{}
+	assert.NoError(t, item.Decode(raw))
+	assert.Equal(t, pck.Item, item)
+}
+
+// TestWallItemUpdatePacket_Integrity tests packet ID, deadline, and rate.
+func TestWallItemUpdatePacket_Integrity(t *testing.T) {
+	pck := &WallItemUpdatePacket{}
+	assert.Equal(t, uint16(WallItemUpdateCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,51 @@
+package promotion
+
+import "pixels-emulator/core/protocol"
+
+// EditRoomEventCode is the unique identifier for the packet
+const EditRoomEventCode = 3991
+
+// EditRoomEventPacket represents a packet sent by client to rename an active room promotion.
+type EditRoomEventPacket struct {
+	Event       int32  // Event is the identifier of the promotion.
+	Title       string // Title is the new name of the promotion.
+	Description string // Description is the new text of the promotion.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *EditRoomEventPacket) Id() uint16 {
+	return EditRoomEventCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *EditRoomEventPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *EditRoomEventPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeEditRoomEventPacket composes a new instance of the packet.
+func ComposeEditRoomEventPacket(pck protocol.RawPacket) (*EditRoomEventPacket, error) {
+
+	e, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	return &EditRoomEventPacket{Event: e, Title: t, Description: d}, nil
+
+}
@@ -0,0 +1,35 @@
+package promotion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeEditRoomEventPacket verifies the packet is composed from its content.
+func TestComposeEditRoomEventPacket(t *testing.T) {
+	raw := protocol.NewPacket(EditRoomEventCode)
+	raw.AddInt(int32(1))
+	raw.AddString("value2")
+	raw.AddString("value3")
+
+	pck, err := ComposeEditRoomEventPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.Event)
+	assert.Equal(t, "value2", pck.Title)
+	assert.Equal(t, "value3", pck.Description)
+
+	_, err = ComposeEditRoomEventPacket(protocol.NewPacket(EditRoomEventCode))
+	assert.Error(t, err)
+}
+
+// TestEditRoomEventPacket_Integrity tests packet ID, deadline, and rate.
+func TestEditRoomEventPacket_Integrity(t *testing.T) {
+	pck := &EditRoomEventPacket{}
+	assert.Equal(t, uint16(EditRoomEventCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
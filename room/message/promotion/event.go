@@ -0,0 +1,53 @@
+package promotion
+
+import "pixels-emulator/core/protocol"
+
+// RoomEventCode is the unique identifier for the packet
+const RoomEventCode = 1840
+
+// RoomEventPacket provides the active promotion of a room.
+// An Ad lower than one notifies the room has no promotion.
+type RoomEventPacket struct {
+	Ad          int32  // Ad is the identifier of the promotion.
+	OwnerId     int32  // OwnerId is the identifier of the room owner.
+	OwnerName   string // OwnerName is the username of the room owner.
+	RoomId      int32  // RoomId is the identifier of the promoted room.
+	Type        int32  // Type is the kind of promotion.
+	Title       string // Title is the name of the promotion.
+	Description string // Description is the text of the promotion.
+	Elapsed     int32  // Elapsed are the minutes since the promotion started.
+	Remaining   int32  // Remaining are the minutes until the promotion expires.
+	Category    int32  // Category is the promotion category.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *RoomEventPacket) Id() uint16 {
+	return RoomEventCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *RoomEventPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *RoomEventPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *RoomEventPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(RoomEventCode)
+	pck.AddInt(p.Ad)
+	pck.AddInt(p.OwnerId)
+	pck.AddString(p.OwnerName)
+	pck.AddInt(p.RoomId)
+	pck.AddInt(p.Type)
+	pck.AddString(p.Title)
+	pck.AddString(p.Description)
+	pck.AddInt(p.Elapsed)
+	pck.AddInt(p.Remaining)
+	pck.AddInt(p.Category)
+	return pck
+}
@@ -0,0 +1,49 @@
+package promotion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestRoomEventPacket_Serialize verifies the packet serialization.
+func TestRoomEventPacket_Serialize(t *testing.T) {
+	pck := &RoomEventPacket{Ad: 4, OwnerId: 1, OwnerName: "john", RoomId: 7, Type: 1, Title: "Party", Description: "Come in", Elapsed: 10, Remaining: 110, Category: 2}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	ad, _ := raw.ReadInt()
+	owner, _ := raw.ReadInt()
+	name, _ := raw.ReadString()
+	room, _ := raw.ReadInt()
+	typ, _ := raw.ReadInt()
+	title, _ := raw.ReadString()
+	desc, _ := raw.ReadString()
+	elapsed, _ := raw.ReadInt()
+	remaining, _ := raw.ReadInt()
+	category, err := raw.ReadInt()
+	assert.NoError(t, err)
+
+	assert.Equal(t, pck.Ad, ad)
+	assert.Equal(t, pck.OwnerId, owner)
+	assert.Equal(t, pck.OwnerName, name)
+	assert.Equal(t, pck.RoomId, room)
+	assert.Equal(t, pck.Type, typ)
+	assert.Equal(t, pck.Title, title)
+	assert.Equal(t, pck.Description, desc)
+	assert.Equal(t, pck.Elapsed, elapsed)
+	assert.Equal(t, pck.Remaining, remaining)
+	assert.Equal(t, pck.Category, category)
+}
+
+// TestRoomEventPacket_Integrity tests packet ID, deadline, and rate.
+func TestRoomEventPacket_Integrity(t *testing.T) {
+	pck := &RoomEventPacket{}
+	assert.Equal(t, uint16(RoomEventCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
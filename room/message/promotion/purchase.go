@@ -0,0 +1,75 @@
+package promotion
+
+import "pixels-emulator/core/protocol"
+
+// PurchaseRoomAdCode is the unique identifier for the packet
+const PurchaseRoomAdCode = 777
+
+// PurchaseRoomAdPacket represents a packet sent by client to buy a promotion of an owned room.
+type PurchaseRoomAdPacket struct {
+	Page        int32  // Page is the catalog page of the offer.
+	Offer       int32  // Offer is the catalog offer bought.
+	Room        int32  // Room is the identifier of the promoted room.
+	Title       string // Title is the name of the promotion.
+	Extended    bool   // Extended defines if the active promotion is extended instead of replaced.
+	Description string // Description is the text of the promotion.
+	Category    int32  // Category is the promotion category.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *PurchaseRoomAdPacket) Id() uint16 {
+	return PurchaseRoomAdCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *PurchaseRoomAdPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *PurchaseRoomAdPacket) Deadline() uint {
+	return 500
+}
+
+// ComposePurchaseRoomAdPacket composes a new instance of the packet.
+func ComposePurchaseRoomAdPacket(pck protocol.RawPacket) (*PurchaseRoomAdPacket, error) {
+
+	pa, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := pck.ReadBoolean()
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &PurchaseRoomAdPacket{Page: pa, Offer: o, Room: r, Title: t, Extended: e, Description: d, Category: c}, nil
+
+}
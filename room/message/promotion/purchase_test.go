@@ -0,0 +1,43 @@
+package promotion
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposePurchaseRoomAdPacket verifies the packet is composed from its content.
+func TestComposePurchaseRoomAdPacket(t *testing.T) {
+	raw := protocol.NewPacket(PurchaseRoomAdCode)
+	raw.AddInt(int32(1))
+	raw.AddInt(int32(2))
+	raw.AddInt(int32(3))
+	raw.AddString("value4")
+	raw.AddBoolean(true)
+	raw.AddString("value6")
+	raw.AddInt(int32(7))
+
+	pck, err := ComposePurchaseRoomAdPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.Page)
+	assert.Equal(t, int32(2), pck.Offer)
+	assert.Equal(t, int32(3), pck.Room)
+	assert.Equal(t, "value4", pck.Title)
+	assert.Equal(t, true, pck.Extended)
+	assert.Equal(t, "value6", pck.Description)
+	assert.Equal(t, int32(7), pck.Category)
+
+	_, err = ComposePurchaseRoomAdPacket(protocol.NewPacket(PurchaseRoomAdCode))
+	assert.Error(t, err)
+}
+
+// TestPurchaseRoomAdPacket_Integrity tests packet ID, deadline, and rate.
+func TestPurchaseRoomAdPacket_Integrity(t *testing.T) {
+	pck := &PurchaseRoomAdPacket{}
+	assert.Equal(t, uint16(PurchaseRoomAdCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
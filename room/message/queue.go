@@ -0,0 +1,44 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// RoomQueueStatusCode is the unique identifier for the packet
+const RoomQueueStatusCode = 2208
+
+// VisitorsQueue is the queue name interpreted by Nitro for regular visitors.
+const VisitorsQueue = "visitors"
+
+// RoomQueueStatusPacket notifies an enqueued user about its position in the room queue.
+type RoomQueueStatusPacket struct {
+	Room     int32 // Room is the identifier of the room being queued.
+	Position int32 // Position is the place of the user in the queue, starting from 1.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *RoomQueueStatusPacket) Id() uint16 {
+	return RoomQueueStatusCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *RoomQueueStatusPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *RoomQueueStatusPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *RoomQueueStatusPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(RoomQueueStatusCode)
+	pck.AddInt(p.Room)
+	pck.AddInt(1) // Amount of queue sets
+	pck.AddString(VisitorsQueue)
+	pck.AddInt(2) // Queue set type
+	pck.AddInt(1) // Amount of queues in the set
+	pck.AddString(VisitorsQueue)
+	pck.AddInt(p.Position)
+	return pck
+}
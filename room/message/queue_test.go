@@ -0,0 +1,43 @@
+package message
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"testing"
+)
+
+// TestRoomQueueStatusPacket_Serialize checks if serialization is made correctly.
+func TestRoomQueueStatusPacket_Serialize(t *testing.T) {
+	pck := &RoomQueueStatusPacket{Room: 12, Position: 3}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(RoomQueueStatusCode), raw.GetHeader())
+
+	room, _ := raw.ReadInt()
+	sets, _ := raw.ReadInt()
+	title, _ := raw.ReadString()
+	kind, _ := raw.ReadInt()
+	queues, _ := raw.ReadInt()
+	name, _ := raw.ReadString()
+	pos, err := raw.ReadInt()
+	assert.NoError(t, err)
+
+	assert.Equal(t, int32(12), room)
+	assert.Equal(t, int32(1), sets)
+	assert.Equal(t, VisitorsQueue, title)
+	assert.Equal(t, int32(2), kind)
+	assert.Equal(t, int32(1), queues)
+	assert.Equal(t, VisitorsQueue, name)
+	assert.Equal(t, int32(3), pos)
+}
+
+// TestRoomQueueStatusPacket check packet attributes.
+func TestRoomQueueStatusPacket(t *testing.T) {
+	pck := &RoomQueueStatusPacket{}
+	assert.Equal(t, uint16(RoomQueueStatusCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
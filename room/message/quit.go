@@ -0,0 +1,32 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// RoomQuitCode is the unique identifier for the packet
+const RoomQuitCode = 105
+
+// RoomQuitPacket is sent by the client when leaving
+// the current room to return to the hotel view.
+type RoomQuitPacket struct {
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *RoomQuitPacket) Id() uint16 {
+	return RoomQuitCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *RoomQuitPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *RoomQuitPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeRoomQuitPacket composes a new instance of the packet.
+func ComposeRoomQuitPacket(_ protocol.RawPacket) (*RoomQuitPacket, error) {
+	return &RoomQuitPacket{}, nil
+}
@@ -0,0 +1,26 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeRoomQuitPacket verifies the packet is composed without content.
+func TestComposeRoomQuitPacket(t *testing.T) {
+	pck, err := ComposeRoomQuitPacket(protocol.NewPacket(RoomQuitCode))
+	assert.NoError(t, err)
+	assert.NotNil(t, pck)
+}
+
+// TestRoomQuitPacket_Integrity tests packet ID, deadline, and rate.
+func TestRoomQuitPacket_Integrity(t *testing.T) {
+	pck := &RoomQuitPacket{}
+	assert.Equal(t, uint16(RoomQuitCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
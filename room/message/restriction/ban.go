@@ -0,0 +1,51 @@
+package restriction
+
+import "pixels-emulator/core/protocol"
+
+// BanCode is the unique identifier for the packet
+const BanCode = 1477
+
+// BanPacket is sent by a room controller to ban a user from the room.
+type BanPacket struct {
+	User int32  // User is the identifier of the banned user.
+	Room int32  // Room is the identifier of the room.
+	Type string // Type is the Nitro ban type defining its duration.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *BanPacket) Id() uint16 {
+	return BanCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *BanPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *BanPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeBanPacket composes a new instance of the packet.
+func ComposeBanPacket(pck protocol.RawPacket) (*BanPacket, error) {
+
+	u, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := pck.ReadString()
+	if err != nil {
+		return nil, err
+	}
+
+	return &BanPacket{User: u, Room: r, Type: t}, nil
+
+}
@@ -0,0 +1,35 @@
+package restriction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeBanPacket verifies the packet is composed from its content.
+func TestComposeBanPacket(t *testing.T) {
+	raw := protocol.NewPacket(BanCode)
+	raw.AddInt(int32(1))
+	raw.AddInt(int32(2))
+	raw.AddString("RWUAM_BAN_USER_DAY")
+
+	pck, err := ComposeBanPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.User)
+	assert.Equal(t, int32(2), pck.Room)
+	assert.Equal(t, "RWUAM_BAN_USER_DAY", pck.Type)
+
+	_, err = ComposeBanPacket(protocol.NewPacket(BanCode))
+	assert.Error(t, err)
+}
+
+// TestBanPacket_Integrity tests packet ID, deadline, and rate.
+func TestBanPacket_Integrity(t *testing.T) {
+	pck := &BanPacket{}
+	assert.Equal(t, uint16(BanCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,39 @@
+package restriction
+
+import "pixels-emulator/core/protocol"
+
+// BanListRequestCode is the unique identifier for the packet
+const BanListRequestCode = 2267
+
+// BanListRequestPacket is sent by a room controller to retrieve the banned users of the room.
+type BanListRequestPacket struct {
+	Room int32 // Room is the identifier of the room.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *BanListRequestPacket) Id() uint16 {
+	return BanListRequestCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *BanListRequestPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *BanListRequestPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeBanListRequestPacket composes a new instance of the packet.
+func ComposeBanListRequestPacket(pck protocol.RawPacket) (*BanListRequestPacket, error) {
+
+	r, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &BanListRequestPacket{Room: r}, nil
+
+}
@@ -0,0 +1,31 @@
+package restriction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeBanListRequestPacket verifies the packet is composed from its content.
+func TestComposeBanListRequestPacket(t *testing.T) {
+	raw := protocol.NewPacket(BanListRequestCode)
+	raw.AddInt(int32(1))
+
+	pck, err := ComposeBanListRequestPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.Room)
+
+	_, err = ComposeBanListRequestPacket(protocol.NewPacket(BanListRequestCode))
+	assert.Error(t, err)
+}
+
+// TestBanListRequestPacket_Integrity tests packet ID, deadline, and rate.
+func TestBanListRequestPacket_Integrity(t *testing.T) {
+	pck := &BanListRequestPacket{}
+	assert.Equal(t, uint16(BanListRequestCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
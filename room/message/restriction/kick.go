@@ -0,0 +1,39 @@
+package restriction
+
+import "pixels-emulator/core/protocol"
+
+// KickCode is the unique identifier for the packet
+const KickCode = 1320
+
+// KickPacket is sent by a room controller to kick a user from the room.
+type KickPacket struct {
+	User int32 // User is the identifier of the kicked user.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *KickPacket) Id() uint16 {
+	return KickCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *KickPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *KickPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeKickPacket composes a new instance of the packet.
+func ComposeKickPacket(pck protocol.RawPacket) (*KickPacket, error) {
+
+	u, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &KickPacket{User: u}, nil
+
+}
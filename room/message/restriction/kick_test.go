@@ -0,0 +1,31 @@
+package restriction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeKickPacket verifies the packet is composed from its content.
+func TestComposeKickPacket(t *testing.T) {
+	raw := protocol.NewPacket(KickCode)
+	raw.AddInt(int32(1))
+
+	pck, err := ComposeKickPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.User)
+
+	_, err = ComposeKickPacket(protocol.NewPacket(KickCode))
+	assert.Error(t, err)
+}
+
+// TestKickPacket_Integrity tests packet ID, deadline, and rate.
+func TestKickPacket_Integrity(t *testing.T) {
+	pck := &KickPacket{}
+	assert.Equal(t, uint16(KickCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
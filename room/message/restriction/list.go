@@ -0,0 +1,46 @@
+package restriction
+
+import "pixels-emulator/core/protocol"
+
+// BanListCode is the unique identifier for the packet
+const BanListCode = 1869
+
+// BannedUser defines a banned user entry of the room settings.
+type BannedUser struct {
+	Id       int32  // Id is the identifier of the banned user.
+	Username string // Username is the name of the banned user.
+}
+
+// BanListPacket sends to the room controllers the banned users of the room.
+type BanListPacket struct {
+	Room  int32        // Room is the identifier of the room.
+	Users []BannedUser // Users are the banned users of the room.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *BanListPacket) Id() uint16 {
+	return BanListCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *BanListPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *BanListPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *BanListPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(BanListCode)
+	pck.AddInt(p.Room)
+	pck.AddInt(int32(len(p.Users)))
+	for _, u := range p.Users {
+		pck.AddInt(u.Id)
+		pck.AddString(u.Username)
+	}
+	return pck
+}
@@ -0,0 +1,40 @@
+package restriction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestBanListPacket_Serialize verifies the packet serialization.
+func TestBanListPacket_Serialize(t *testing.T) {
+	pck := &BanListPacket{Room: 5, Users: []BannedUser{{Id: 1, Username: "john"}, {Id: 2, Username: "jane"}}}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	r, _ := raw.ReadInt()
+	assert.Equal(t, int32(5), r)
+
+	n, _ := raw.ReadInt()
+	assert.Equal(t, int32(2), n)
+
+	for _, u := range pck.Users {
+		id, _ := raw.ReadInt()
+		name, err := raw.ReadString()
+		assert.NoError(t, err)
+		assert.Equal(t, u.Id, id)
+		assert.Equal(t, u.Username, name)
+	}
+}
+
+// TestBanListPacket_Integrity tests packet ID, deadline, and rate.
+func TestBanListPacket_Integrity(t *testing.T) {
+	pck := &BanListPacket{}
+	assert.Equal(t, uint16(BanListCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
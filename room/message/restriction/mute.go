@@ -0,0 +1,51 @@
+package restriction
+
+import "pixels-emulator/core/protocol"
+
+// MuteCode is the unique identifier for the packet
+const MuteCode = 3485
+
+// MutePacket is sent by a room controller to prohibit a user to chat.
+type MutePacket struct {
+	User    int32 // User is the identifier of the muted user.
+	Room    int32 // Room is the identifier of the room.
+	Minutes int32 // Minutes is the duration of the mute.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *MutePacket) Id() uint16 {
+	return MuteCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *MutePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *MutePacket) Deadline() uint {
+	return 500
+}
+
+// ComposeMutePacket composes a new instance of the packet.
+func ComposeMutePacket(pck protocol.RawPacket) (*MutePacket, error) {
+
+	u, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &MutePacket{User: u, Room: r, Minutes: m}, nil
+
+}
@@ -0,0 +1,35 @@
+package restriction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeMutePacket verifies the packet is composed from its content.
+func TestComposeMutePacket(t *testing.T) {
+	raw := protocol.NewPacket(MuteCode)
+	raw.AddInt(int32(1))
+	raw.AddInt(int32(2))
+	raw.AddInt(int32(3))
+
+	pck, err := ComposeMutePacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.User)
+	assert.Equal(t, int32(2), pck.Room)
+	assert.Equal(t, int32(3), pck.Minutes)
+
+	_, err = ComposeMutePacket(protocol.NewPacket(MuteCode))
+	assert.Error(t, err)
+}
+
+// TestMutePacket_Integrity tests packet ID, deadline, and rate.
+func TestMutePacket_Integrity(t *testing.T) {
+	pck := &MutePacket{}
+	assert.Equal(t, uint16(MuteCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
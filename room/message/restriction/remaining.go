@@ -0,0 +1,34 @@
+package restriction
+
+import "pixels-emulator/core/protocol"
+
+// RemainingMuteCode is the unique identifier for the packet
+const RemainingMuteCode = 826
+
+// RemainingMutePacket notifies a muted user the remaining time of the mute.
+type RemainingMutePacket struct {
+	Seconds int32 // Seconds is the remaining time of the mute.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *RemainingMutePacket) Id() uint16 {
+	return RemainingMuteCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *RemainingMutePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *RemainingMutePacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *RemainingMutePacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(RemainingMuteCode)
+	pck.AddInt(p.Seconds)
+	return pck
+}
@@ -0,0 +1,30 @@
+package restriction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestRemainingMutePacket_Serialize verifies the packet serialization.
+func TestRemainingMutePacket_Serialize(t *testing.T) {
+	pck := &RemainingMutePacket{Seconds: 1}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	s, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), s)
+}
+
+// TestRemainingMutePacket_Integrity tests packet ID, deadline, and rate.
+func TestRemainingMutePacket_Integrity(t *testing.T) {
+	pck := &RemainingMutePacket{}
+	assert.Equal(t, uint16(RemainingMuteCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
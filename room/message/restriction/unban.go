@@ -0,0 +1,45 @@
+package restriction
+
+import "pixels-emulator/core/protocol"
+
+// UnbanCode is the unique identifier for the packet
+const UnbanCode = 992
+
+// UnbanPacket is sent by a room controller to lift the ban of a user.
+type UnbanPacket struct {
+	User int32 // User is the identifier of the banned user.
+	Room int32 // Room is the identifier of the room.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *UnbanPacket) Id() uint16 {
+	return UnbanCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *UnbanPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *UnbanPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeUnbanPacket composes a new instance of the packet.
+func ComposeUnbanPacket(pck protocol.RawPacket) (*UnbanPacket, error) {
+
+	u, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &UnbanPacket{User: u, Room: r}, nil
+
+}
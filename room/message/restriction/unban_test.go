@@ -0,0 +1,33 @@
+package restriction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeUnbanPacket verifies the packet is composed from its content.
+func TestComposeUnbanPacket(t *testing.T) {
+	raw := protocol.NewPacket(UnbanCode)
+	raw.AddInt(int32(1))
+	raw.AddInt(int32(2))
+
+	pck, err := ComposeUnbanPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.User)
+	assert.Equal(t, int32(2), pck.Room)
+
+	_, err = ComposeUnbanPacket(protocol.NewPacket(UnbanCode))
+	assert.Error(t, err)
+}
+
+// TestUnbanPacket_Integrity tests packet ID, deadline, and rate.
+func TestUnbanPacket_Integrity(t *testing.T) {
+	pck := &UnbanPacket{}
+	assert.Equal(t, uint16(UnbanCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,36 @@
+package restriction
+
+import "pixels-emulator/core/protocol"
+
+// UnbannedCode is the unique identifier for the packet
+const UnbannedCode = 3429
+
+// UnbannedPacket notifies the room controllers a user ban was lifted.
+type UnbannedPacket struct {
+	Room int32 // Room is the identifier of the room.
+	User int32 // User is the identifier of the unbanned user.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *UnbannedPacket) Id() uint16 {
+	return UnbannedCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *UnbannedPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *UnbannedPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *UnbannedPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(UnbannedCode)
+	pck.AddInt(p.Room)
+	pck.AddInt(p.User)
+	return pck
+}
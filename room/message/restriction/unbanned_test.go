@@ -0,0 +1,33 @@
+package restriction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestUnbannedPacket_Serialize verifies the packet serialization.
+func TestUnbannedPacket_Serialize(t *testing.T) {
+	pck := &UnbannedPacket{Room: 1, User: 2}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	r, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), r)
+	u, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), u)
+}
+
+// TestUnbannedPacket_Integrity tests packet ID, deadline, and rate.
+func TestUnbannedPacket_Integrity(t *testing.T) {
+	pck := &UnbannedPacket{}
+	assert.Equal(t, uint16(UnbannedCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,38 @@
+package rights
+
+import "pixels-emulator/core/protocol"
+
+// ControllerAddedCode is the unique identifier for the packet
+const ControllerAddedCode = 2088
+
+// ControllerAddedPacket notifies the room owner a user received rights.
+type ControllerAddedPacket struct {
+	Room     int32  // Room is the identifier of the room.
+	User     int32  // User is the identifier of the user with rights.
+	Username string // Username is the name of the user with rights.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *ControllerAddedPacket) Id() uint16 {
+	return ControllerAddedCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *ControllerAddedPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *ControllerAddedPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *ControllerAddedPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(ControllerAddedCode)
+	pck.AddInt(p.Room)
+	pck.AddInt(p.User)
+	pck.AddString(p.Username)
+	return pck
+}
@@ -0,0 +1,39 @@
+package rights
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestControllerAddedPacket_Serialize verifies the packet serialization.
+func TestControllerAddedPacket_Serialize(t *testing.T) {
+	pck := &ControllerAddedPacket{Room: int32(1), User: int32(2), Username: "value3"}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(ControllerAddedCode), raw.GetHeader())
+
+	r0, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), r0)
+
+	u1, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), u1)
+
+	u2, err := raw.ReadString()
+	assert.NoError(t, err)
+	assert.Equal(t, "value3", u2)
+}
+
+// TestControllerAddedPacket_Integrity tests packet ID, deadline, and rate.
+func TestControllerAddedPacket_Integrity(t *testing.T) {
+	pck := &ControllerAddedPacket{}
+	assert.Equal(t, uint16(ControllerAddedCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
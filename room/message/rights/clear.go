@@ -0,0 +1,39 @@
+package rights
+
+import "pixels-emulator/core/protocol"
+
+// RemoveAllRightsCode is the unique identifier for the packet
+const RemoveAllRightsCode = 2683
+
+// RemoveAllRightsPacket is sent by the room owner to revoke the rights of every user.
+type RemoveAllRightsPacket struct {
+	Room int32 // Room is the identifier of the room.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *RemoveAllRightsPacket) Id() uint16 {
+	return RemoveAllRightsCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *RemoveAllRightsPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *RemoveAllRightsPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeRemoveAllRightsPacket composes a new instance of the packet.
+func ComposeRemoveAllRightsPacket(pck protocol.RawPacket) (*RemoveAllRightsPacket, error) {
+
+	r, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &RemoveAllRightsPacket{Room: r}, nil
+
+}
@@ -0,0 +1,31 @@
+package rights
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeRemoveAllRightsPacket verifies the packet is composed from its content.
+func TestComposeRemoveAllRightsPacket(t *testing.T) {
+	raw := protocol.NewPacket(RemoveAllRightsCode)
+	raw.AddInt(int32(1))
+
+	pck, err := ComposeRemoveAllRightsPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.Room)
+
+	_, err = ComposeRemoveAllRightsPacket(protocol.NewPacket(RemoveAllRightsCode))
+	assert.Error(t, err)
+}
+
+// TestRemoveAllRightsPacket_Integrity tests packet ID, deadline, and rate.
+func TestRemoveAllRightsPacket_Integrity(t *testing.T) {
+	pck := &RemoveAllRightsPacket{}
+	assert.Equal(t, uint16(RemoveAllRightsCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
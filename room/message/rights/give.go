@@ -0,0 +1,39 @@
+package rights
+
+import "pixels-emulator/core/protocol"
+
+// GiveRightsCode is the unique identifier for the packet
+const GiveRightsCode = 808
+
+// GiveRightsPacket is sent by the room owner to grant rights to a user.
+type GiveRightsPacket struct {
+	User int32 // User is the identifier of the user receiving rights.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *GiveRightsPacket) Id() uint16 {
+	return GiveRightsCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *GiveRightsPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *GiveRightsPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeGiveRightsPacket composes a new instance of the packet.
+func ComposeGiveRightsPacket(pck protocol.RawPacket) (*GiveRightsPacket, error) {
+
+	u, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &GiveRightsPacket{User: u}, nil
+
+}
@@ -0,0 +1,31 @@
+package rights
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeGiveRightsPacket verifies the packet is composed from its content.
+func TestComposeGiveRightsPacket(t *testing.T) {
+	raw := protocol.NewPacket(GiveRightsCode)
+	raw.AddInt(int32(1))
+
+	pck, err := ComposeGiveRightsPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.User)
+
+	_, err = ComposeGiveRightsPacket(protocol.NewPacket(GiveRightsCode))
+	assert.Error(t, err)
+}
+
+// TestGiveRightsPacket_Integrity tests packet ID, deadline, and rate.
+func TestGiveRightsPacket_Integrity(t *testing.T) {
+	pck := &GiveRightsPacket{}
+	assert.Equal(t, uint16(GiveRightsCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
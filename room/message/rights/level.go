@@ -0,0 +1,34 @@
+package rights
+
+import "pixels-emulator/core/protocol"
+
+// RightsLevelCode is the unique identifier for the packet
+const RightsLevelCode = 780
+
+// RightsLevelPacket notifies a player its flat control level in the room.
+type RightsLevelPacket struct {
+	Level int32 // Level is the flat control level of the player.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *RightsLevelPacket) Id() uint16 {
+	return RightsLevelCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *RightsLevelPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *RightsLevelPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *RightsLevelPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(RightsLevelCode)
+	pck.AddInt(p.Level)
+	return pck
+}
@@ -0,0 +1,31 @@
+package rights
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestRightsLevelPacket_Serialize verifies the packet serialization.
+func TestRightsLevelPacket_Serialize(t *testing.T) {
+	pck := &RightsLevelPacket{Level: int32(1)}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(RightsLevelCode), raw.GetHeader())
+
+	l0, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), l0)
+}
+
+// TestRightsLevelPacket_Integrity tests packet ID, deadline, and rate.
+func TestRightsLevelPacket_Integrity(t *testing.T) {
+	pck := &RightsLevelPacket{}
+	assert.Equal(t, uint16(RightsLevelCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,46 @@
+package rights
+
+import "pixels-emulator/core/protocol"
+
+// ControllersCode is the unique identifier for the packet
+const ControllersCode = 1284
+
+// Controller defines a user with rights of the room settings.
+type Controller struct {
+	Id       int32  // Id is the identifier of the user with rights.
+	Username string // Username is the name of the user with rights.
+}
+
+// ControllersPacket sends to the room owner the users with rights of the room.
+type ControllersPacket struct {
+	Room  int32        // Room is the identifier of the room.
+	Users []Controller // Users are the users with rights of the room.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *ControllersPacket) Id() uint16 {
+	return ControllersCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *ControllersPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *ControllersPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *ControllersPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(ControllersCode)
+	pck.AddInt(p.Room)
+	pck.AddInt(int32(len(p.Users)))
+	for _, u := range p.Users {
+		pck.AddInt(u.Id)
+		pck.AddString(u.Username)
+	}
+	return pck
+}
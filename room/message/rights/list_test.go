@@ -0,0 +1,40 @@
+package rights
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestControllersPacket_Serialize verifies the packet serialization.
+func TestControllersPacket_Serialize(t *testing.T) {
+	pck := &ControllersPacket{Room: 5, Users: []Controller{{Id: 1, Username: "john"}, {Id: 2, Username: "jane"}}}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	r, _ := raw.ReadInt()
+	assert.Equal(t, int32(5), r)
+
+	n, _ := raw.ReadInt()
+	assert.Equal(t, int32(2), n)
+
+	for _, u := range pck.Users {
+		id, _ := raw.ReadInt()
+		name, err := raw.ReadString()
+		assert.NoError(t, err)
+		assert.Equal(t, u.Id, id)
+		assert.Equal(t, u.Username, name)
+	}
+}
+
+// TestControllersPacket_Integrity tests packet ID, deadline, and rate.
+func TestControllersPacket_Integrity(t *testing.T) {
+	pck := &ControllersPacket{}
+	assert.Equal(t, uint16(ControllersCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
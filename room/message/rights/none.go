@@ -0,0 +1,32 @@
+package rights
+
+import "pixels-emulator/core/protocol"
+
+// NoRightsCode is the unique identifier for the packet
+const NoRightsCode = 2392
+
+// NoRightsPacket notifies a player it has no longer flat control in the room.
+type NoRightsPacket struct {
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *NoRightsPacket) Id() uint16 {
+	return NoRightsCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *NoRightsPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *NoRightsPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *NoRightsPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(NoRightsCode)
+	return pck
+}
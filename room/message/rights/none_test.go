@@ -0,0 +1,27 @@
+package rights
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestNoRightsPacket_Serialize verifies the packet serialization.
+func TestNoRightsPacket_Serialize(t *testing.T) {
+	pck := &NoRightsPacket{}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(NoRightsCode), raw.GetHeader())
+}
+
+// TestNoRightsPacket_Integrity tests packet ID, deadline, and rate.
+func TestNoRightsPacket_Integrity(t *testing.T) {
+	pck := &NoRightsPacket{}
+	assert.Equal(t, uint16(NoRightsCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
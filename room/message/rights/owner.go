@@ -0,0 +1,32 @@
+package rights
+
+import "pixels-emulator/core/protocol"
+
+// RoomOwnerCode is the unique identifier for the packet
+const RoomOwnerCode = 339
+
+// RoomOwnerPacket notifies a player it is the owner of the room.
+type RoomOwnerPacket struct {
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *RoomOwnerPacket) Id() uint16 {
+	return RoomOwnerCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *RoomOwnerPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *RoomOwnerPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *RoomOwnerPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(RoomOwnerCode)
+	return pck
+}
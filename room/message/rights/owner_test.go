@@ -0,0 +1,27 @@
+package rights
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestRoomOwnerPacket_Serialize verifies the packet serialization.
+func TestRoomOwnerPacket_Serialize(t *testing.T) {
+	pck := &RoomOwnerPacket{}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(RoomOwnerCode), raw.GetHeader())
+}
+
+// TestRoomOwnerPacket_Integrity tests packet ID, deadline, and rate.
+func TestRoomOwnerPacket_Integrity(t *testing.T) {
+	pck := &RoomOwnerPacket{}
+	assert.Equal(t, uint16(RoomOwnerCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,48 @@
+package rights
+
+import "pixels-emulator/core/protocol"
+
+// RemoveRightsCode is the unique identifier for the packet
+const RemoveRightsCode = 2064
+
+// RemoveRightsPacket is sent by the room owner to revoke the rights of users.
+type RemoveRightsPacket struct {
+	Users []int32 // Users are the identifiers of the users losing rights.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *RemoveRightsPacket) Id() uint16 {
+	return RemoveRightsCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *RemoveRightsPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *RemoveRightsPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeRemoveRightsPacket composes a new instance of the packet.
+func ComposeRemoveRightsPacket(pck protocol.RawPacket) (*RemoveRightsPacket, error) {
+
+	uSize, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	u := make([]int32, 0, uSize)
+	for i := int32(0); i < uSize; i++ {
+		id, err := pck.ReadInt()
+		if err != nil {
+			return nil, err
+		}
+		u = append(u, id)
+	}
+
+	return &RemoveRightsPacket{Users: u}, nil
+
+}
@@ -0,0 +1,33 @@
+package rights
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeRemoveRightsPacket verifies the packet is composed from its content.
+func TestComposeRemoveRightsPacket(t *testing.T) {
+	raw := protocol.NewPacket(RemoveRightsCode)
+	raw.AddInt(2)
+	raw.AddInt(1)
+	raw.AddInt(2)
+
+	pck, err := ComposeRemoveRightsPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{1, 2}, pck.Users)
+
+	_, err = ComposeRemoveRightsPacket(protocol.NewPacket(RemoveRightsCode))
+	assert.Error(t, err)
+}
+
+// TestRemoveRightsPacket_Integrity tests packet ID, deadline, and rate.
+func TestRemoveRightsPacket_Integrity(t *testing.T) {
+	pck := &RemoveRightsPacket{}
+	assert.Equal(t, uint16(RemoveRightsCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,36 @@
+package rights
+
+import "pixels-emulator/core/protocol"
+
+// ControllerRemovedCode is the unique identifier for the packet
+const ControllerRemovedCode = 1327
+
+// ControllerRemovedPacket notifies the room owner a user lost its rights.
+type ControllerRemovedPacket struct {
+	Room int32 // Room is the identifier of the room.
+	User int32 // User is the identifier of the user without rights.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *ControllerRemovedPacket) Id() uint16 {
+	return ControllerRemovedCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *ControllerRemovedPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *ControllerRemovedPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *ControllerRemovedPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(ControllerRemovedCode)
+	pck.AddInt(p.Room)
+	pck.AddInt(p.User)
+	return pck
+}
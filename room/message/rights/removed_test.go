@@ -0,0 +1,35 @@
+package rights
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestControllerRemovedPacket_Serialize verifies the packet serialization.
+func TestControllerRemovedPacket_Serialize(t *testing.T) {
+	pck := &ControllerRemovedPacket{Room: int32(1), User: int32(2)}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(ControllerRemovedCode), raw.GetHeader())
+
+	r0, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), r0)
+
+	u1, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), u1)
+}
+
+// TestControllerRemovedPacket_Integrity tests packet ID, deadline, and rate.
+func TestControllerRemovedPacket_Integrity(t *testing.T) {
+	pck := &ControllerRemovedPacket{}
+	assert.Equal(t, uint16(ControllerRemovedCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
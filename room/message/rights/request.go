@@ -0,0 +1,39 @@
+package rights
+
+import "pixels-emulator/core/protocol"
+
+// RightsListRequestCode is the unique identifier for the packet
+const RightsListRequestCode = 3385
+
+// RightsListRequestPacket is sent by the room owner to retrieve the users with rights.
+type RightsListRequestPacket struct {
+	Room int32 // Room is the identifier of the room.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *RightsListRequestPacket) Id() uint16 {
+	return RightsListRequestCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *RightsListRequestPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *RightsListRequestPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeRightsListRequestPacket composes a new instance of the packet.
+func ComposeRightsListRequestPacket(pck protocol.RawPacket) (*RightsListRequestPacket, error) {
+
+	r, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &RightsListRequestPacket{Room: r}, nil
+
+}
@@ -0,0 +1,31 @@
+package rights
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeRightsListRequestPacket verifies the packet is composed from its content.
+func TestComposeRightsListRequestPacket(t *testing.T) {
+	raw := protocol.NewPacket(RightsListRequestCode)
+	raw.AddInt(int32(1))
+
+	pck, err := ComposeRightsListRequestPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.Room)
+
+	_, err = ComposeRightsListRequestPacket(protocol.NewPacket(RightsListRequestCode))
+	assert.Error(t, err)
+}
+
+// TestRightsListRequestPacket_Integrity tests packet ID, deadline, and rate.
+func TestRightsListRequestPacket_Integrity(t *testing.T) {
+	pck := &RightsListRequestPacket{}
+	assert.Equal(t, uint16(RightsListRequestCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,39 @@
+package score
+
+import "pixels-emulator/core/protocol"
+
+// RateCode is the unique identifier for the packet
+const RateCode = 3582
+
+// RatePacket represents a packet sent by client when a player likes the room.
+type RatePacket struct {
+	Points int32 // Points are the points given to the room, only likes are supported by Nitro.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *RatePacket) Id() uint16 {
+	return RateCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *RatePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *RatePacket) Deadline() uint {
+	return 500
+}
+
+// ComposeRatePacket composes a new instance of the packet.
+func ComposeRatePacket(pck protocol.RawPacket) (*RatePacket, error) {
+
+	po, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &RatePacket{Points: po}, nil
+
+}
@@ -0,0 +1,31 @@
+package score
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeRatePacket verifies the packet is composed from its content.
+func TestComposeRatePacket(t *testing.T) {
+	raw := protocol.NewPacket(RateCode)
+	raw.AddInt(int32(1))
+
+	pck, err := ComposeRatePacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.Points)
+
+	_, err = ComposeRatePacket(protocol.NewPacket(RateCode))
+	assert.Error(t, err)
+}
+
+// TestRatePacket_Integrity tests packet ID, deadline, and rate.
+func TestRatePacket_Integrity(t *testing.T) {
+	pck := &RatePacket{}
+	assert.Equal(t, uint16(RateCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,36 @@
+package score
+
+import "pixels-emulator/core/protocol"
+
+// ScoreCode is the unique identifier for the packet
+const ScoreCode = 482
+
+// ScorePacket provides the score of the room and if the player is still able to like it.
+type ScorePacket struct {
+	Score   int32 // Score is the amount of votes of the room.
+	CanLike bool  // CanLike defines if the like button is shown to the player.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *ScorePacket) Id() uint16 {
+	return ScoreCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *ScorePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *ScorePacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *ScorePacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(ScoreCode)
+	pck.AddInt(p.Score)
+	pck.AddBoolean(p.CanLike)
+	return pck
+}
@@ -0,0 +1,35 @@
+package score
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestScorePacket_Serialize verifies the packet serialization.
+func TestScorePacket_Serialize(t *testing.T) {
+	pck := &ScorePacket{Score: int32(1), CanLike: true}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(ScoreCode), raw.GetHeader())
+
+	s0, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), s0)
+
+	c1, err := raw.ReadBoolean()
+	assert.NoError(t, err)
+	assert.Equal(t, true, c1)
+}
+
+// TestScorePacket_Integrity tests packet ID, deadline, and rate.
+func TestScorePacket_Integrity(t *testing.T) {
+	pck := &ScorePacket{}
+	assert.Equal(t, uint16(ScoreCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,37 @@
+package settings
+
+import (
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+)
+
+// ChatSettingsCode is the unique identifier for the packet
+const ChatSettingsCode = 1191
+
+// ChatSettingsPacket notifies the players the chat settings of the room.
+type ChatSettingsPacket struct {
+	Settings *encode.RoomChatSettings // Settings contains the room chat settings.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *ChatSettingsPacket) Id() uint16 {
+	return ChatSettingsCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *ChatSettingsPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *ChatSettingsPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *ChatSettingsPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(ChatSettingsCode)
+	p.Settings.Encode(&pck)
+	return pck
+}
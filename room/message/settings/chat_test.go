@@ -0,0 +1,35 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+)
+
+// TestChatSettingsPacket_Serialize verifies the packet serialization.
+func TestChatSettingsPacket_Serialize(t *testing.T) {
+	s := &encode.RoomChatSettings{Mode: 1, Weight: 2, Speed: 0, Distance: 14, Protection: 2}
+	pck := &ChatSettingsPacket{Settings: s}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	dec := &encode.RoomChatSettings{}
+	assert.NoError(t, dec.Decode(raw))
+	assert.Equal(t, s.Mode, dec.Mode)
+	assert.Equal(t, s.Weight, dec.Weight)
+	assert.Equal(t, s.Distance, dec.Distance)
+	assert.Equal(t, s.Protection, dec.Protection)
+}
+
+// TestChatSettingsPacket_Integrity tests packet ID, deadline, and rate.
+func TestChatSettingsPacket_Integrity(t *testing.T) {
+	pck := &ChatSettingsPacket{}
+	assert.Equal(t, uint16(ChatSettingsCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,63 @@
+package settings
+
+import "pixels-emulator/core/protocol"
+
+// SettingsCode is the unique identifier for the packet
+const SettingsCode = 1498
+
+// SettingsPacket sends to the room owner the current room settings.
+type SettingsPacket struct {
+	Settings         // Settings are the current room settings.
+	UsersLimit int32 // UsersLimit is the maximum value allowed for UsersMax.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *SettingsPacket) Id() uint16 {
+	return SettingsCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *SettingsPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *SettingsPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *SettingsPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(SettingsCode)
+	pck.AddInt(p.Room)
+	pck.AddString(p.Name)
+	pck.AddString(p.Description)
+	pck.AddInt(int32(p.Door))
+	pck.AddInt(p.Category)
+	pck.AddInt(p.UsersMax)
+	pck.AddInt(p.UsersLimit)
+	pck.AddInt(int32(len(p.Tags)))
+	for _, t := range p.Tags {
+		pck.AddString(t)
+	}
+	pck.AddInt(p.TradeMode)
+	pck.AddInt(flag(p.AllowPets))
+	pck.AddInt(flag(p.AllowPetsFeed))
+	pck.AddInt(flag(p.AllowWalkThrough))
+	pck.AddInt(flag(p.HideWall))
+	pck.AddInt(p.WallThickness)
+	pck.AddInt(p.FloorThickness)
+	p.Chat.Encode(&pck)
+	pck.AddBoolean(false) // Navigator dynamic categories
+	p.Moderation.Encode(&pck)
+	return pck
+}
+
+// flag encodes a boolean as the integer expected by Nitro.
+func flag(b bool) int32 {
+	if b {
+		return 1
+	}
+	return 0
+}
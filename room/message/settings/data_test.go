@@ -0,0 +1,65 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+)
+
+// TestSettingsPacket_Serialize verifies the packet serialization.
+func TestSettingsPacket_Serialize(t *testing.T) {
+	pck := &SettingsPacket{
+		Settings: Settings{
+			Room:        7,
+			Name:        "name",
+			Description: "description",
+			Door:        encode.Locked,
+			UsersMax:    25,
+			Tags:        []string{"fun"},
+			TradeMode:   1,
+			AllowPets:   true,
+			Moderation:  &encode.ModerationRights{},
+			Chat:        &encode.RoomChatSettings{Distance: 12},
+		},
+		UsersLimit: 50,
+	}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	room, _ := raw.ReadInt()
+	name, _ := raw.ReadString()
+	desc, _ := raw.ReadString()
+	door, _ := raw.ReadInt()
+	_, _ = raw.ReadInt()
+	max, _ := raw.ReadInt()
+	limit, _ := raw.ReadInt()
+	tags, _ := raw.ReadInt()
+	tag, _ := raw.ReadString()
+	trade, _ := raw.ReadInt()
+	pets, err := raw.ReadInt()
+	assert.NoError(t, err)
+
+	assert.Equal(t, int32(7), room)
+	assert.Equal(t, "name", name)
+	assert.Equal(t, "description", desc)
+	assert.Equal(t, int32(encode.Locked), door)
+	assert.Equal(t, int32(25), max)
+	assert.Equal(t, int32(50), limit)
+	assert.Equal(t, int32(1), tags)
+	assert.Equal(t, "fun", tag)
+	assert.Equal(t, int32(1), trade)
+	assert.Equal(t, int32(1), pets)
+}
+
+// TestSettingsPacket_Integrity tests packet ID, deadline, and rate.
+func TestSettingsPacket_Integrity(t *testing.T) {
+	pck := &SettingsPacket{}
+	assert.Equal(t, uint16(SettingsCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
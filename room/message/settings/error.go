@@ -0,0 +1,38 @@
+package settings
+
+import "pixels-emulator/core/protocol"
+
+// SettingsErrorCode is the unique identifier for the packet
+const SettingsErrorCode = 1555
+
+// SettingsErrorPacket notifies the room owner the settings were rejected.
+type SettingsErrorPacket struct {
+	Room    int32  // Room is the identifier of the room.
+	Code    int32  // Code is the Nitro reason of the rejection.
+	Message string // Message is the detail of the rejection.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *SettingsErrorPacket) Id() uint16 {
+	return SettingsErrorCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *SettingsErrorPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *SettingsErrorPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *SettingsErrorPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(SettingsErrorCode)
+	pck.AddInt(p.Room)
+	pck.AddInt(p.Code)
+	pck.AddString(p.Message)
+	return pck
+}
@@ -0,0 +1,39 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestSettingsErrorPacket_Serialize verifies the packet serialization.
+func TestSettingsErrorPacket_Serialize(t *testing.T) {
+	pck := &SettingsErrorPacket{Room: int32(1), Code: int32(2), Message: "value3"}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(SettingsErrorCode), raw.GetHeader())
+
+	r0, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), r0)
+
+	c1, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), c1)
+
+	m2, err := raw.ReadString()
+	assert.NoError(t, err)
+	assert.Equal(t, "value3", m2)
+}
+
+// TestSettingsErrorPacket_Integrity tests packet ID, deadline, and rate.
+func TestSettingsErrorPacket_Integrity(t *testing.T) {
+	pck := &SettingsErrorPacket{}
+	assert.Equal(t, uint16(SettingsErrorCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
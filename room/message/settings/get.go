@@ -0,0 +1,39 @@
+package settings
+
+import "pixels-emulator/core/protocol"
+
+// GetSettingsCode is the unique identifier for the packet
+const GetSettingsCode = 3129
+
+// GetSettingsPacket is sent by the room owner to retrieve the room settings.
+type GetSettingsPacket struct {
+	Room int32 // Room is the identifier of the room.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *GetSettingsPacket) Id() uint16 {
+	return GetSettingsCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *GetSettingsPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *GetSettingsPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeGetSettingsPacket composes a new instance of the packet.
+func ComposeGetSettingsPacket(pck protocol.RawPacket) (*GetSettingsPacket, error) {
+
+	r, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetSettingsPacket{Room: r}, nil
+
+}
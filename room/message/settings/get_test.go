@@ -0,0 +1,31 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestComposeGetSettingsPacket verifies the packet is composed from its content.
+func TestComposeGetSettingsPacket(t *testing.T) {
+	raw := protocol.NewPacket(GetSettingsCode)
+	raw.AddInt(int32(1))
+
+	pck, err := ComposeGetSettingsPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), pck.Room)
+
+	_, err = ComposeGetSettingsPacket(protocol.NewPacket(GetSettingsCode))
+	assert.Error(t, err)
+}
+
+// TestGetSettingsPacket_Integrity tests packet ID, deadline, and rate.
+func TestGetSettingsPacket_Integrity(t *testing.T) {
+	pck := &GetSettingsPacket{}
+	assert.Equal(t, uint16(GetSettingsCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
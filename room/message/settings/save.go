@@ -0,0 +1,99 @@
+package settings
+
+import (
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+)
+
+// SaveSettingsCode is the unique identifier for the packet
+const SaveSettingsCode = 1969
+
+// SaveSettingsPacket is sent by the room owner to update the room settings.
+type SaveSettingsPacket struct {
+	Settings // Settings are the requested room settings.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *SaveSettingsPacket) Id() uint16 {
+	return SaveSettingsCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *SaveSettingsPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *SaveSettingsPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeSaveSettingsPacket composes a new instance of the packet.
+func ComposeSaveSettingsPacket(pck protocol.RawPacket) (*SaveSettingsPacket, error) {
+
+	s := Settings{
+		Moderation: &encode.ModerationRights{},
+		Chat:       &encode.RoomChatSettings{},
+	}
+
+	var err error
+	var door, tags int32
+	ints := func(dst ...*int32) {
+		for _, d := range dst {
+			if err != nil {
+				return
+			}
+			*d, err = pck.ReadInt()
+		}
+	}
+	bools := func(dst ...*bool) {
+		for _, d := range dst {
+			if err != nil {
+				return
+			}
+			*d, err = pck.ReadBoolean()
+		}
+	}
+	texts := func(dst ...*string) {
+		for _, d := range dst {
+			if err != nil {
+				return
+			}
+			*d, err = pck.ReadString()
+		}
+	}
+
+	ints(&s.Room)
+	texts(&s.Name, &s.Description)
+	ints(&door)
+	texts(&s.Password)
+	ints(&s.UsersMax, &s.Category, &tags)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Door = encode.Door(door)
+	s.Tags = make([]string, tags)
+	for i := range s.Tags {
+		texts(&s.Tags[i])
+	}
+
+	ints(&s.TradeMode)
+	bools(&s.AllowPets, &s.AllowPetsFeed, &s.AllowWalkThrough, &s.HideWall)
+	ints(&s.WallThickness, &s.FloorThickness)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.Moderation.Decode(&pck); err != nil {
+		return nil, err
+	}
+
+	if err = s.Chat.Decode(&pck); err != nil {
+		return nil, err
+	}
+
+	return &SaveSettingsPacket{Settings: s}, nil
+
+}
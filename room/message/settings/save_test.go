@@ -0,0 +1,66 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+	"pixels-emulator/room/encode"
+)
+
+// TestComposeSaveSettingsPacket verifies the packet is composed from its content.
+func TestComposeSaveSettingsPacket(t *testing.T) {
+	raw := protocol.NewPacket(SaveSettingsCode)
+	raw.AddInt(7)
+	raw.AddString("name")
+	raw.AddString("description")
+	raw.AddInt(int32(encode.PasswordProtected))
+	raw.AddString("secret")
+	raw.AddInt(25)
+	raw.AddInt(3)
+	raw.AddInt(2)
+	raw.AddString("fun")
+	raw.AddString("game")
+	raw.AddInt(2)
+	raw.AddBoolean(true)
+	raw.AddBoolean(false)
+	raw.AddBoolean(true)
+	raw.AddBoolean(false)
+	raw.AddInt(1)
+	raw.AddInt(-1)
+	(&encode.ModerationRights{Mute: encode.Rights, Kick: encode.None, Ban: encode.Administrator}).Encode(&raw)
+	(&encode.RoomChatSettings{Mode: 1, Weight: 2, Speed: 0, Distance: 12, Protection: 2}).Encode(&raw)
+
+	pck, err := ComposeSaveSettingsPacket(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(7), pck.Room)
+	assert.Equal(t, "name", pck.Name)
+	assert.Equal(t, "description", pck.Description)
+	assert.Equal(t, encode.Door(encode.PasswordProtected), pck.Door)
+	assert.Equal(t, "secret", pck.Password)
+	assert.Equal(t, int32(25), pck.UsersMax)
+	assert.Equal(t, int32(3), pck.Category)
+	assert.Equal(t, []string{"fun", "game"}, pck.Tags)
+	assert.Equal(t, int32(2), pck.TradeMode)
+	assert.True(t, pck.AllowPets)
+	assert.False(t, pck.AllowPetsFeed)
+	assert.True(t, pck.AllowWalkThrough)
+	assert.False(t, pck.HideWall)
+	assert.Equal(t, int32(1), pck.WallThickness)
+	assert.Equal(t, int32(-1), pck.FloorThickness)
+	assert.Equal(t, encode.Level(encode.Administrator), pck.Moderation.Ban)
+	assert.Equal(t, int32(12), pck.Chat.Distance)
+
+	_, err = ComposeSaveSettingsPacket(protocol.NewPacket(SaveSettingsCode))
+	assert.Error(t, err)
+}
+
+// TestSaveSettingsPacket_Integrity tests packet ID, deadline, and rate.
+func TestSaveSettingsPacket_Integrity(t *testing.T) {
+	pck := &SaveSettingsPacket{}
+	assert.Equal(t, uint16(SaveSettingsCode), pck.Id())
+	assert.Equal(t, uint(500), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
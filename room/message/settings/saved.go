@@ -0,0 +1,34 @@
+package settings
+
+import "pixels-emulator/core/protocol"
+
+// SettingsSavedCode is the unique identifier for the packet
+const SettingsSavedCode = 948
+
+// SettingsSavedPacket notifies the room owner the settings were saved.
+type SettingsSavedPacket struct {
+	Room int32 // Room is the identifier of the room.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *SettingsSavedPacket) Id() uint16 {
+	return SettingsSavedCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *SettingsSavedPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *SettingsSavedPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *SettingsSavedPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(SettingsSavedCode)
+	pck.AddInt(p.Room)
+	return pck
+}
@@ -0,0 +1,31 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestSettingsSavedPacket_Serialize verifies the packet serialization.
+func TestSettingsSavedPacket_Serialize(t *testing.T) {
+	pck := &SettingsSavedPacket{Room: int32(1)}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(SettingsSavedCode), raw.GetHeader())
+
+	r0, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), r0)
+}
+
+// TestSettingsSavedPacket_Integrity tests packet ID, deadline, and rate.
+func TestSettingsSavedPacket_Integrity(t *testing.T) {
+	pck := &SettingsSavedPacket{}
+	assert.Equal(t, uint16(SettingsSavedCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
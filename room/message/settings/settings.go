@@ -0,0 +1,24 @@
+package settings
+
+import "pixels-emulator/room/encode"
+
+// Settings defines the editable settings of a room, shared by the settings editor packets.
+type Settings struct {
+	Room             int32                    // Room is the identifier of the room.
+	Name             string                   // Name is the display name of the room.
+	Description      string                   // Description is the textual description of the room.
+	Door             encode.Door              // Door is the room access state.
+	Password         string                   // Password is the plain password, only provided when saving.
+	Category         int32                    // Category is the navigation category ID of the room.
+	UsersMax         int32                    // UsersMax is the maximum number of users allowed in the room.
+	Tags             []string                 // Tags are the keywords associated with the room.
+	TradeMode        int32                    // TradeMode is the trading mode. (0: Closed, 1: Rights only, 2: Open)
+	AllowPets        bool                     // AllowPets indicates whether pets are allowed in the room.
+	AllowPetsFeed    bool                     // AllowPetsFeed indicates whether pets are allowed to feed in the room.
+	AllowWalkThrough bool                     // AllowWalkThrough indicates whether users can walk through each other.
+	HideWall         bool                     // HideWall indicates whether the room walls are hidden.
+	WallThickness    int32                    // WallThickness is the thickness of the room walls.
+	FloorThickness   int32                    // FloorThickness is the thickness of the room floor.
+	Moderation       *encode.ModerationRights // Moderation defines who can mute, kick and ban.
+	Chat             *encode.RoomChatSettings // Chat contains the room chat settings.
+}
@@ -0,0 +1,34 @@
+package settings
+
+import "pixels-emulator/core/protocol"
+
+// SettingsUpdatedCode is the unique identifier for the packet
+const SettingsUpdatedCode = 3297
+
+// SettingsUpdatedPacket notifies the players the room settings changed, so room data is requested again.
+type SettingsUpdatedPacket struct {
+	Room int32 // Room is the identifier of the room.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *SettingsUpdatedPacket) Id() uint16 {
+	return SettingsUpdatedCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *SettingsUpdatedPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *SettingsUpdatedPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *SettingsUpdatedPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(SettingsUpdatedCode)
+	pck.AddInt(p.Room)
+	return pck
+}
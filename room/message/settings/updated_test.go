@@ -0,0 +1,31 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestSettingsUpdatedPacket_Serialize verifies the packet serialization.
+func TestSettingsUpdatedPacket_Serialize(t *testing.T) {
+	pck := &SettingsUpdatedPacket{Room: int32(1)}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(SettingsUpdatedCode), raw.GetHeader())
+
+	r0, err := raw.ReadInt()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), r0)
+}
+
+// TestSettingsUpdatedPacket_Integrity tests packet ID, deadline, and rate.
+func TestSettingsUpdatedPacket_Integrity(t *testing.T) {
+	pck := &SettingsUpdatedPacket{}
+	assert.Equal(t, uint16(SettingsUpdatedCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
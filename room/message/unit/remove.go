@@ -0,0 +1,37 @@
+package unit
+
+import (
+	"pixels-emulator/core/protocol"
+	"strconv"
+)
+
+// RemoveCode is the unique identifier for the packet
+const RemoveCode = 2661
+
+// RemovePacket notifies a room unit is no longer in the room.
+type RemovePacket struct {
+	Unit int32 // Unit defines the identifier of the removed unit.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *RemovePacket) Id() uint16 {
+	return RemoveCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *RemovePacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *RemovePacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *RemovePacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(RemoveCode)
+	pck.AddString(strconv.Itoa(int(p.Unit)))
+	return pck
+}
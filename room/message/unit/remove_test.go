@@ -0,0 +1,31 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestRemovePacket_Serialize verifies the unit is serialized as string.
+func TestRemovePacket_Serialize(t *testing.T) {
+	pck := &RemovePacket{Unit: 12}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	id, err := raw.ReadString()
+	assert.NoError(t, err)
+	assert.Equal(t, "12", id)
+}
+
+// TestRemovePacket_Integrity tests packet ID, deadline, and rate.
+func TestRemovePacket_Integrity(t *testing.T) {
+	pck := &RemovePacket{}
+	assert.Equal(t, uint16(RemoveCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,45 @@
+package unit
+
+import "pixels-emulator/core/protocol"
+
+// WalkCode is the unique identifier for the packet
+const WalkCode = 3320
+
+// WalkPacket defines a request from the client to move
+// the avatar unit to a specific tile of the room.
+type WalkPacket struct {
+	X, Y int32 // X, Y defines the target tile coordinates.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *WalkPacket) Id() uint16 {
+	return WalkCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *WalkPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *WalkPacket) Deadline() uint {
+	return 500
+}
+
+// ComposeWalkPacket composes a new instance of the packet.
+func ComposeWalkPacket(pck protocol.RawPacket) (*WalkPacket, error) {
+
+	x, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := pck.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+
+	return &WalkPacket{X: x, Y: y}, nil
+
+}
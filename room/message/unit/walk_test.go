@@ -0,0 +1,50 @@
+package unit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// walkPacket is a demo walk packet.
+var walkPacket = &WalkPacket{X: 4, Y: 7}
+
+func encodeWalkPacket(dec *WalkPacket) *protocol.RawPacket {
+	pck := protocol.NewPacket(WalkCode)
+	pck.AddInt(dec.X)
+	pck.AddInt(dec.Y)
+	return &pck
+}
+
+// TestComposeWalkPacket verifies the packet is decoded correctly.
+func TestComposeWalkPacket(t *testing.T) {
+	raw, err := protocol.FromBytes(encodeWalkPacket(walkPacket).ToBytes())
+	assert.NoError(t, err)
+
+	pck, err := ComposeWalkPacket(*raw)
+	assert.NoError(t, err)
+	assert.Equal(t, walkPacket.X, pck.X)
+	assert.Equal(t, walkPacket.Y, pck.Y)
+}
+
+// TestComposeWalkPacket_Missing verifies incomplete packets are rejected.
+func TestComposeWalkPacket_Missing(t *testing.T) {
+	pck := protocol.NewPacket(WalkCode)
+	pck.AddInt(4)
+	raw, err := protocol.FromBytes(pck.ToBytes())
+	assert.NoError(t, err)
+
+	_, err = ComposeWalkPacket(*raw)
+	assert.Error(t, err)
+}
+
+// TestWalkPacket_Integrity tests packet ID, deadline, and rate.
+func TestWalkPacket_Integrity(t *testing.T) {
+	assert.Equal(t, uint16(WalkCode), walkPacket.Id())
+	assert.Equal(t, uint(500), walkPacket.Deadline())
+
+	mn, mx := walkPacket.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -22,3 +22,8 @@ func (m *MemoryStore) Limits() *util.AttemptLimiter {
 	args := m.Called()
 	return args.Get(0).(*util.AttemptLimiter)
 }
+
+func (m *MemoryStore) Loads() *util.KeyedMutex {
+	args := m.Called()
+	return args.Get(0).(*util.KeyedMutex)
+}
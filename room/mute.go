@@ -0,0 +1,26 @@
+package room
+
+import "time"
+
+// Mute prohibits a player to chat in the room until the moment provided.
+func (r *Room) Mute(id string, until time.Time) {
+	r.mutes.Store(id, until)
+}
+
+// MutedFor provides the remaining mute of a player, zero if not muted.
+func (r *Room) MutedFor(id string, now time.Time) time.Duration {
+
+	v, ok := r.mutes.Load(id)
+	if !ok {
+		return 0
+	}
+
+	left := v.(time.Time).Sub(now)
+	if left <= 0 {
+		r.mutes.Delete(id)
+		return 0
+	}
+
+	return left
+
+}
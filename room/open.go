@@ -3,12 +3,16 @@ package room
 import (
 	"context"
 	"go.uber.org/zap"
+	ev "pixels-emulator/room/event"
 	"pixels-emulator/room/message"
 	"pixels-emulator/room/path"
 	"pixels-emulator/user"
 )
 
-func (r *Room) Open(p *user.Player, c *path.Coordinate) {
+// Open places a player in the room on the coordinate provided or the door, syncing
+// the units with the players in-game. If queue is set, the player is enqueued instead
+// while the room is full. Returns false if the room was unloaded.
+func (r *Room) Open(p *user.Player, c *path.Coordinate, queue bool) bool {
 
 	var err error
 	defer func() {
@@ -19,58 +23,86 @@ func (r *Room) Open(p *user.Player, c *path.Coordinate) {
 		}
 	}()
 
+	r.mu.Lock()
+	if r.unloaded {
+		r.mu.Unlock()
+		return false
+	}
+
+	// Capacity is checked along the admission, so concurrent players can not exceed it.
+	if queue && r.full() {
+		r.enqueue(p)
+		r.mu.Unlock()
+		return true
+	}
+
 	r.ready = true
 	if !r.ready {
 		r.Transitioning[p.Id] = p
-		return
+		r.mu.Unlock()
+		return true
 	}
 
+	r.Queue.Remove(p.Id)
 	r.Players[p.Id] = p
-	p.Conn().SendPacket(&message.RoomReadyPacket{Room: int32(r.Id), Layout: r.Layout().Slug()})
-
-	// Updates position to the tile on the coordinate provided or the room door.
-	var tile path.Coordinate
-	door := path.NewCoordinate(
-		r.Layout().Door().X(),
-		r.Layout().Door().Y(),
-		r.Layout().Door().Z(),
-		r.Layout().Door().Dir(),
-	)
-
-	if c == nil {
-		tile = door
-	} else {
-		tile = *c
-	}
+	r.emptySince.Store(0)
+	r.tk.Register(r.key(), r)
+	p.Conn().SendPacket(&message.RoomReadyPacket{Room: int32(r.Id), Layout: r.l.Slug()})
 
-	p.Unit().Current = tile
-	p.Unit().SetRotation(tile.Dir(), tile.Dir())
+	r.settle(p, c)
 
 	// Prepare player array
 	var roomP []*user.Player
 	for _, v := range r.Players {
 		roomP = append(roomP, v)
 	}
+	r.mu.Unlock()
+
+	// Units are encoded while the cycle can not move them.
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 
 	err = SendUnitDetailPacket(context.Background(), r, roomP, p)
 	if err != nil {
-		return
+		return true
 	}
 
 	// Send new player packet for old players
 	for _, online := range roomP {
 		err = SendUnitSyncPacket([]*user.Player{p}, online)
 		if err != nil {
-			return
+			return true
 		}
 	}
 
 	// Send online player packet for new player
 	err = SendUnitSyncPacket(roomP, p)
-	if err != nil {
-		return
+	if err == nil {
+		r.em.Fire(ev.RoomEnterEventName, ev.NewRoomEnterEvent(r.Id, p.Id, 0, make(map[string]string)))
 	}
+	return true
 
-	// TODO: If enqueued, prevent opening and send to queue.
+}
+
+// settle updates the player position to the tile on the coordinate provided or the
+// room door. The room lock must be held.
+func (r *Room) settle(p *user.Player, c *path.Coordinate) {
+
+	tile := path.NewCoordinate(
+		r.l.Door().X(),
+		r.l.Door().Y(),
+		r.l.Door().Z(),
+		r.l.Door().Dir(),
+	)
+
+	if c != nil {
+		tile = *c
+	}
+
+	p.Unit().Current = tile
+	p.Unit().SetRotation(tile.Dir(), tile.Dir())
+	if t := p.Unit().GetCurrentTile(r.l); t != nil {
+		t.AddUnit(p.Id)
+	}
 
 }
@@ -49,6 +49,29 @@ func GetTileInFront(layout *Layout, tile *Tile, rotation Direction, offset int)
 	return layout.grid[x][y]
 }
 
+// CalculateDirection provides the rotation needed to face a tile from another one.
+func CalculateDirection(from, to *Tile) Direction {
+	dx, dy := sign(int(to.X)-int(from.X)), sign(int(to.Y)-int(from.Y))
+	for i, dir := range directions {
+		if dir[0] == dx && dir[1] == dy {
+			return Direction(i)
+		}
+	}
+	return North
+}
+
+// sign provides the sign of an integer as -1, 0 or 1.
+func sign(n int) int {
+	switch {
+	case n > 0:
+		return 1
+	case n < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
 // GetAdjacentTiles returns all adjacent tiles based on the allowDiagonal parameter.
 // If allowDiagonal is true, returns neighbors in 8 directions; otherwise, only in 4 cardinal directions.
 func GetAdjacentTiles(layout *Layout, tile *Tile, allowDiagonal bool) []*Tile {
@@ -125,3 +125,31 @@ func TestGetAdjacentTiles(t *testing.T) {
 		assert.Len(t, adjacent, 5)
 	})
 }
+
+// TestCalculateDirection verifies the rotation between two tiles.
+func TestCalculateDirection(t *testing.T) {
+	center := &Tile{X: 2, Y: 2}
+
+	tests := []struct {
+		name     string
+		x, y     int16
+		expected Direction
+	}{
+		{"North", 2, 1, North},
+		{"NorthEast", 3, 1, NorthEast},
+		{"East", 3, 2, East},
+		{"SouthEast", 3, 3, SouthEast},
+		{"South", 2, 3, South},
+		{"SouthWest", 1, 3, SouthWest},
+		{"West", 1, 2, West},
+		{"NorthWest", 1, 1, NorthWest},
+		{"Far East", 5, 2, East},
+		{"Same tile", 2, 2, North},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CalculateDirection(center, &Tile{X: tt.x, Y: tt.y}))
+		})
+	}
+}
@@ -24,6 +24,17 @@ type Node struct {
 	index   int
 }
 
+// NewRequest creates a pathfinding request for a unit between two tiles of the layout.
+func NewRequest(l *Layout, base, target *Tile, unit interface{}, walkthrough bool) *Request {
+	return &Request{
+		layout:           l,
+		basePosition:     base,
+		targetPosition:   target,
+		unit:             unit,
+		allowWalkthrough: walkthrough,
+	}
+}
+
 // Base provides abstract coordinate of base point.
 func (pr *Request) Base() Coordinate {
 	return NewCoordinate(pr.basePosition.X, pr.basePosition.Y, pr.basePosition.Z, 0)
@@ -34,6 +45,27 @@ func (pr *Request) Target() Coordinate {
 	return NewCoordinate(pr.targetPosition.X, pr.targetPosition.Y, pr.targetPosition.Z, 0)
 }
 
+// Path provides the remaining tiles of the calculated path, including the current one.
+func (pr *Request) Path() []*Tile {
+	return pr.path
+}
+
+// Next advances the calculated path one step and provides the following tile.
+// Returns nil when the path has been completely walked.
+func (pr *Request) Next() *Tile {
+	if len(pr.path) < 2 {
+		pr.path = nil
+		return nil
+	}
+	pr.path = pr.path[1:]
+	return pr.path[0]
+}
+
+// Finished checks if the calculated path has no more steps to walk.
+func (pr *Request) Finished() bool {
+	return len(pr.path) < 2
+}
+
 // CalculatePath finds the shortest path using the A* algorithm.
 // It starts from the basePosition and attempts to reach the targetPosition.
 func (pr *Request) CalculatePath(diag bool) []*Tile {
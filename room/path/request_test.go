@@ -177,3 +177,29 @@ func TestCalculatePath_NarrowPassage(t *testing.T) {
 	assert.NotNil(t, path, "Path should be found through the narrow passage")
 	assert.Greater(t, len(path), 0, "Path should contain steps")
 }
+
+func TestRequest_Next(t *testing.T) {
+	layout := newTestLayoutWithObstacles(3, 1, map[string]bool{})
+	req := NewRequest(layout, layout.grid[0][0], layout.grid[2][0], nil, false)
+
+	path := req.CalculatePath(false)
+	assert.Len(t, path, 3)
+	assert.False(t, req.Finished())
+
+	assert.Equal(t, layout.grid[1][0], req.Next())
+	assert.Equal(t, layout.grid[2][0], req.Next())
+	assert.True(t, req.Finished())
+	assert.Nil(t, req.Next())
+	assert.Empty(t, req.Path())
+}
+
+func TestNewRequest_Coordinates(t *testing.T) {
+	layout := newTestLayoutWithObstacles(3, 3, map[string]bool{})
+	req := NewRequest(layout, layout.grid[0][1], layout.grid[2][2], nil, false)
+
+	assert.Equal(t, int16(0), req.Base().X())
+	assert.Equal(t, int16(1), req.Base().Y())
+	assert.Equal(t, int16(2), req.Target().X())
+	assert.Equal(t, int16(2), req.Target().Y())
+	assert.Nil(t, req.Path())
+}
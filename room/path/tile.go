@@ -24,11 +24,11 @@ type Tile struct {
 	State     Status   // State defines the current accessibility status of the tile.
 	Diagonal  bool     // Diagonal defines if tile allow diagonal.
 	stackable bool     // stackable defines if the tile allows stack.
-	height    int      // Height defines the actual stackable height of the tile.
+	height    float64  // Height defines the actual stackable height of the tile.
 }
 
 // UpdateHeight updates the height according to the tile context and value.
-func (t *Tile) UpdateHeight(height int) {
+func (t *Tile) UpdateHeight(height float64) {
 
 	if t.State == Invalid {
 		t.height = math.MaxInt16
@@ -42,13 +42,13 @@ func (t *Tile) UpdateHeight(height int) {
 		return
 	}
 
-	t.height = int(t.Z)
+	t.height = float64(t.Z)
 	t.stackable = false
 
 }
 
 // Height return the height of the tile.
-func (t *Tile) Height() int {
+func (t *Tile) Height() float64 {
 	return t.height
 }
 
@@ -73,10 +73,30 @@ func (t *Tile) RelativeHeight() int {
 		return 64 * 256
 	}
 
-	return t.Height() * 256
+	return int(t.Height() * 256)
 
 }
 
+// AddUnit registers a room unit as standing on the tile.
+func (t *Tile) AddUnit(id string) {
+	for _, u := range t.Units {
+		if u == id {
+			return
+		}
+	}
+	t.Units = append(t.Units, id)
+}
+
+// RemoveUnit removes a room unit from the tile.
+func (t *Tile) RemoveUnit(id string) {
+	for i, u := range t.Units {
+		if u == id {
+			t.Units = append(t.Units[:i], t.Units[i+1:]...)
+			return
+		}
+	}
+}
+
 // Walkable checks if a tile can be walked on based on its state, height constraints, and presence of units.
 func (t *Tile) Walkable(canFall bool, currentAdj *Tile, isFinalDestination bool) bool {
 	// If there are units on the tile, it is not walkable.
@@ -85,7 +105,7 @@ func (t *Tile) Walkable(canFall bool, currentAdj *Tile, isFinalDestination bool)
 	}
 
 	// Calculate height difference
-	heightDiff := float64(t.height - currentAdj.height)
+	heightDiff := t.height - currentAdj.height
 
 	// Prevent movement if the height difference is too large
 	if (!canFall && heightDiff < -MaxHeight) || (heightDiff > MaxHeight) {
@@ -110,7 +130,7 @@ func NewTile(x, y, z int16, status Status, stack bool) *Tile {
 		X:         x,
 		Y:         y,
 		Z:         z,
-		height:    int(z),
+		height:    float64(z),
 		Units:     make([]string, 0),
 		State:     status,
 		Diagonal:  false,
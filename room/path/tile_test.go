@@ -99,42 +99,60 @@ func TestUpdateHeight(t *testing.T) {
 	t.Run("Invalid Tile", func(t *testing.T) {
 		tile := NewTile(1, 1, 0, Invalid, true)
 		tile.UpdateHeight(5)
-		assert.Equal(t, math.MaxInt16, tile.Height())
+		assert.Equal(t, float64(math.MaxInt16), tile.Height())
 		assert.False(t, tile.Stackable())
 	})
 
 	t.Run("Valid Tile with Positive Height", func(t *testing.T) {
 		tile := NewTile(1, 1, 0, Open, true)
 		tile.UpdateHeight(5)
-		assert.Equal(t, 5, tile.Height())
+		assert.Equal(t, 5.0, tile.Height())
 		assert.True(t, tile.Stackable())
 	})
 
 	t.Run("Valid Tile with Zero Height", func(t *testing.T) {
 		tile := NewTile(1, 1, 0, Open, true)
 		tile.UpdateHeight(0)
-		assert.Equal(t, 0, tile.Height())
+		assert.Equal(t, 0.0, tile.Height())
 		assert.True(t, tile.Stackable())
 	})
 
 	t.Run("Tile with MaxInt16 Height", func(t *testing.T) {
 		tile := NewTile(1, 1, 0, Open, true)
 		tile.UpdateHeight(math.MaxInt16)
-		assert.Equal(t, math.MaxInt16, tile.Height())
+		assert.Equal(t, float64(math.MaxInt16), tile.Height())
 		assert.True(t, tile.Stackable())
 	})
 
 	t.Run("Tile with Negative Height", func(t *testing.T) {
 		tile := NewTile(1, 1, 5, Open, true)
 		tile.UpdateHeight(-3)
-		assert.Equal(t, -3, tile.Height())
+		assert.Equal(t, -3.0, tile.Height())
 		assert.True(t, tile.Stackable())
 	})
 
 	t.Run("Tile Keeps Initial Height if Unchanged", func(t *testing.T) {
 		tile := NewTile(1, 1, 7, Open, true)
 		tile.UpdateHeight(tile.Height())
-		assert.Equal(t, 7, tile.Height())
+		assert.Equal(t, 7.0, tile.Height())
 		assert.True(t, tile.Stackable())
 	})
 }
+
+// TestTileUnits verifies units are registered and removed from the tile.
+func TestTileUnits(t *testing.T) {
+	tile := NewTile(1, 1, 0, Open, true)
+	adjacent := NewTile(1, 2, 0, Open, true)
+
+	tile.AddUnit("1")
+	tile.AddUnit("1")
+	assert.Equal(t, []string{"1"}, tile.Units)
+	assert.False(t, tile.Walkable(false, adjacent, false))
+
+	tile.RemoveUnit("2")
+	assert.Len(t, tile.Units, 1)
+
+	tile.RemoveUnit("1")
+	assert.Empty(t, tile.Units)
+	assert.True(t, tile.Walkable(false, adjacent, false))
+}
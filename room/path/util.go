@@ -15,8 +15,8 @@ func GetFlatHeights(l *Layout) []int16 {
 
 			if !l.TileExists(x, y) {
 				heights[i] = math.MaxInt16
-			} else { // You can force this to be non-stackable but, I do not intend to create a non-stackable emulator.
-				heights[i] = int16(l.GetTile(x, y).Height())
+			} else { // Stacked heights are sent in 1/256 units, flagging the tiles where stacking is blocked.
+				heights[i] = int16(l.GetTile(x, y).RelativeHeight())
 			}
 			i++
 
@@ -1,6 +1,7 @@
 package path
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -24,11 +25,13 @@ func TestGetFlatHeights(t *testing.T) {
 
 	assert.Equal(t, 16, len(heights), "El largo del slice debe ser 9")
 
+	// Heights are relative to 1/256, void tiles are sent as the highest value.
+	v := int16(math.MaxInt16)
 	expected := []int16{
-		0, 0, 0, 0,
-		0, 2, 2, 0,
-		0, 0, 1, 0,
-		0, 0, 0, 0,
+		v, v, v, v,
+		v, 2 * 256, 2 * 256, v,
+		v, 0, 1 * 256, v,
+		v, v, v, v,
 	}
 
 	assert.Equal(t, expected, heights)
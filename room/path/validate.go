@@ -0,0 +1,88 @@
+package path
+
+import (
+	"errors"
+	"pixels-emulator/core/model"
+	"strings"
+)
+
+// MaxLayoutLength defines the longest side of a custom heightmap in tiles.
+const MaxLayoutLength = 64
+
+// HeightCharacters defines the legal characters of a custom heightmap, 'x' being void tiles.
+const HeightCharacters = "x0123456789abcdefghijklmnopqrstuvw"
+
+var (
+	ErrLayoutSize      = errors.New("layout exceeds the maximum dimensions")  // ErrLayoutSize rejects empty or oversized layouts.
+	ErrLayoutRows      = errors.New("layout rows have different lengths")     // ErrLayoutRows rejects non-rectangular layouts.
+	ErrLayoutCharacter = errors.New("layout has illegal height characters")   // ErrLayoutCharacter rejects unknown heights.
+	ErrLayoutDoor      = errors.New("layout door is not a valid tile")        // ErrLayoutDoor rejects doors placed on void tiles.
+	ErrLayoutIsland    = errors.New("layout has tiles unreachable from door") // ErrLayoutIsland rejects disconnected tiles.
+)
+
+// ValidateHeightMap checks a custom heightmap can be used as room layout,
+// providing the generated layout when valid. Heights are not considered
+// for reachability, as stairs can be built with furniture later.
+func ValidateHeightMap(hMap *model.HeightMap) (*Layout, error) {
+
+	raw := strings.ReplaceAll(strings.ReplaceAll(hMap.Heightmap, "\n", ""), "\\n", "")
+	rows := strings.Split(raw, "\\r")
+
+	if len(rows) > MaxLayoutLength || len(rows[0]) == 0 || len(rows[0]) > MaxLayoutLength {
+		return nil, ErrLayoutSize
+	}
+
+	for _, row := range rows {
+		if len(row) != len(rows[0]) {
+			return nil, ErrLayoutRows
+		}
+		if strings.Trim(row, HeightCharacters) != "" {
+			return nil, ErrLayoutCharacter
+		}
+	}
+
+	if hMap.DoorY < 0 || hMap.DoorY >= len(rows) || hMap.DoorX < 0 || hMap.DoorX >= len(rows[0]) || rows[hMap.DoorY][hMap.DoorX] == 'x' {
+		return nil, ErrLayoutDoor
+	}
+
+	l, err := NewLayout(hMap)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reachability ignores heights, flooding every valid tile from the door.
+	base := l.DoorTile()
+	reachable := map[*Tile]struct{}{base: {}}
+	queue := []*Tile{base}
+
+	for len(queue) > 0 {
+		t := queue[0]
+		queue = queue[1:]
+
+		for _, n := range GetAdjacentTiles(l, t, true) {
+			if n == nil || n.State == Invalid {
+				continue
+			}
+			if _, ok := reachable[n]; ok {
+				continue
+			}
+			reachable[n] = struct{}{}
+			queue = append(queue, n)
+		}
+	}
+
+	for x := 0; x < l.xLen; x++ {
+		for y := 0; y < l.yLen; y++ {
+			t := l.grid[x][y]
+			if t == nil || t.State == Invalid {
+				continue
+			}
+			if _, ok := reachable[t]; !ok {
+				return nil, ErrLayoutIsland
+			}
+		}
+	}
+
+	return l, nil
+
+}
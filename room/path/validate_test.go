@@ -0,0 +1,64 @@
+package path
+
+import (
+	"pixels-emulator/core/model"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// heightMap creates a custom heightmap with the door at 1,1.
+func heightMap(rows ...string) *model.HeightMap {
+	return &model.HeightMap{
+		Slug:          "custom_1",
+		DoorX:         1,
+		DoorY:         1,
+		DoorDirection: 2,
+		Heightmap:     strings.Join(rows, "\\r\\n"),
+	}
+}
+
+// TestValidateHeightMap verifies a valid custom heightmap generates its layout.
+func TestValidateHeightMap(t *testing.T) {
+	l, err := ValidateHeightMap(heightMap("xxxx", "x00x", "x1ax", "xxxx"))
+	assert.NoError(t, err)
+	assert.NotNil(t, l)
+	assert.Equal(t, int16(10), l.GetTile(2, 2).Z)
+}
+
+// TestValidateHeightMap_Size verifies oversized heightmaps are rejected.
+func TestValidateHeightMap_Size(t *testing.T) {
+	_, err := ValidateHeightMap(heightMap("x00x", "x"+strings.Repeat("0", MaxLayoutLength)))
+	assert.ErrorIs(t, err, ErrLayoutRows)
+
+	_, err = ValidateHeightMap(heightMap(strings.Repeat("0", MaxLayoutLength+1)))
+	assert.ErrorIs(t, err, ErrLayoutSize)
+}
+
+// TestValidateHeightMap_Character verifies unknown heights are rejected.
+func TestValidateHeightMap_Character(t *testing.T) {
+	_, err := ValidateHeightMap(heightMap("xxx", "x0!", "xxx"))
+	assert.ErrorIs(t, err, ErrLayoutCharacter)
+
+	_, err = ValidateHeightMap(heightMap("xxx", "x0z", "xxx"))
+	assert.ErrorIs(t, err, ErrLayoutCharacter)
+}
+
+// TestValidateHeightMap_Door verifies doors must be placed on valid tiles.
+func TestValidateHeightMap_Door(t *testing.T) {
+	_, err := ValidateHeightMap(heightMap("xxx", "xx0", "xxx"))
+	assert.ErrorIs(t, err, ErrLayoutDoor)
+
+	_, err = ValidateHeightMap(heightMap("0"))
+	assert.ErrorIs(t, err, ErrLayoutDoor)
+}
+
+// TestValidateHeightMap_Island verifies disconnected tiles are rejected regardless of heights.
+func TestValidateHeightMap_Island(t *testing.T) {
+	_, err := ValidateHeightMap(heightMap("xxxxx", "x0x0x", "xxxxx"))
+	assert.ErrorIs(t, err, ErrLayoutIsland)
+
+	_, err = ValidateHeightMap(heightMap("xxxxx", "x09wx", "xxx0x"))
+	assert.NoError(t, err)
+}
@@ -36,7 +36,7 @@ func GlyphMap(l *Layout, path []*Tile, flatMode bool) string {
 					if flatMode {
 						sb.WriteString("O")
 					} else {
-						sb.WriteString(fmt.Sprintf("%d", tile.Height()))
+						sb.WriteString(fmt.Sprintf("%d", int(tile.Height())))
 					}
 				case Blocked:
 					sb.WriteString("#")
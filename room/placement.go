@@ -0,0 +1,360 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"pixels-emulator/core/model"
+	"pixels-emulator/room/message"
+	"pixels-emulator/room/message/item"
+	"pixels-emulator/room/path"
+	"regexp"
+)
+
+// MaxStackHeight defines the highest an item can be stacked.
+const MaxStackHeight = 40.0
+
+var (
+	ErrItemNotFound    = errors.New("item not found")                             // ErrItemNotFound rejects items not owned or not placed.
+	ErrPlacementTile   = errors.New("item out of the room layout")                // ErrPlacementTile rejects items over invalid tiles.
+	ErrPlacementDoor   = errors.New("item placed on the door")                    // ErrPlacementDoor rejects items over the door tile.
+	ErrPlacementUnit   = errors.New("item placed over a unit")                    // ErrPlacementUnit rejects solid items over units.
+	ErrPlacementStack  = errors.New("item stacked over a non stackable tile")     // ErrPlacementStack rejects items over items not allowing stacking.
+	ErrPlacementHeight = errors.New("item stacked too high or over uneven tiles") // ErrPlacementHeight rejects items out of the stacking limits.
+	ErrPlacementWall   = errors.New("invalid wall position")                      // ErrPlacementWall rejects malformed wall positions.
+	ErrPlacementBelow  = errors.New("item with items stacked over")               // ErrPlacementBelow rejects moving items holding other items.
+	ErrPlacementRotate = errors.New("invalid item rotation")                      // ErrPlacementRotate rejects rotations other than the straight ones.
+)
+
+// wallPosition matches the Nitro wall positions, as ":w=0,2 l=11,31 r".
+var wallPosition = regexp.MustCompile(`^:w=\d+,\d+ l=-?\d+,-?\d+ [lr]$`)
+
+// Placed provides the room item of an inventory item, not yet placed.
+func Placed(from *model.InventoryItem) *model.RoomItem {
+	return &model.RoomItem{
+		UserID:        from.UserID,
+		User:          from.User,
+		DefinitionID:  from.DefinitionID,
+		Definition:    from.Definition,
+		ExtraData:     from.ExtraData,
+		LimitedNumber: from.LimitedNumber,
+		LimitedStack:  from.LimitedStack,
+	}
+}
+
+// Kept provides the inventory item of a room item, not yet picked up.
+func Kept(from *model.RoomItem) *model.InventoryItem {
+	return &model.InventoryItem{
+		UserID:        from.UserID,
+		User:          from.User,
+		DefinitionID:  from.DefinitionID,
+		Definition:    from.Definition,
+		ExtraData:     from.ExtraData,
+		LimitedNumber: from.LimitedNumber,
+		LimitedStack:  from.LimitedStack,
+	}
+}
+
+// Item provides an item placed in the room.
+func (r *Room) Item(id uint) (*model.RoomItem, error) {
+	r.itemMu.RLock()
+	defer r.itemMu.RUnlock()
+	i, ok := r.items[id]
+	if !ok {
+		return nil, ErrItemNotFound
+	}
+	return i, nil
+}
+
+// CanPlace checks if a floor item fits at a position, providing the height where it is placed.
+// Every tile of its footprint must be valid and stackable, away from the door and, unless the
+// item can be walked over, from the units. The item is stacked over the highest tile, which
+// can not be more than path.MaxHeight over the lowest one. Only straight rotations are allowed.
+func (r *Room) CanPlace(i *model.RoomItem, x, y, rot int) (float64, error) {
+
+	if rot < int(path.North) || rot > int(path.West) || rot%2 != 0 {
+		return 0, ErrPlacementRotate
+	}
+
+	// Tiles are read while the cycle can not walk over them.
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	moved := *i
+	moved.X, moved.Y, moved.Rotation = x, y, rot
+	w, l := Size(&moved)
+	solid := !i.Definition.AllowWalk && !i.Definition.AllowSit && !i.Definition.AllowLay
+
+	lowest, highest := MaxStackHeight, 0.0
+	for tx := x; tx < x+w; tx++ {
+		for ty := y; ty < y+l; ty++ {
+
+			if !r.l.TileExists(tx, ty) {
+				return 0, ErrPlacementTile
+			}
+
+			t := r.l.GetTile(tx, ty)
+			if t.State == path.Invalid {
+				return 0, ErrPlacementTile
+			}
+
+			if t == r.l.DoorTile() {
+				return 0, ErrPlacementDoor
+			}
+
+			if solid && len(t.Units) > 0 {
+				return 0, ErrPlacementUnit
+			}
+
+			var below []*model.RoomItem
+			for _, b := range r.ItemsAt(tx, ty) {
+				if b.ID != i.ID {
+					below = append(below, b)
+				}
+			}
+
+			h, _, stackable := Stack(float64(t.Z), below)
+			if !stackable {
+				return 0, ErrPlacementStack
+			}
+
+			lowest, highest = min(lowest, h), max(highest, h)
+
+		}
+	}
+
+	if highest-lowest > path.MaxHeight || highest+i.Definition.StackHeight > MaxStackHeight {
+		return 0, ErrPlacementHeight
+	}
+
+	return highest, nil
+
+}
+
+// Holds checks if a floor item has other items stacked over any tile it covers.
+func (r *Room) Holds(i *model.RoomItem) bool {
+
+	top := i.Z + i.Definition.StackHeight
+	w, l := Size(i)
+	for x := i.X; x < i.X+w; x++ {
+		for y := i.Y; y < i.Y+l; y++ {
+			for _, o := range r.ItemsAt(x, y) {
+				if o.ID != i.ID && o.Z >= top {
+					return true
+				}
+			}
+		}
+	}
+	return false
+
+}
+
+// PlaceFloorItem places an item of the user inventory on the room tiles, notifying the players in-game.
+func (r *Room) PlaceFloorItem(ctx context.Context, db *gorm.DB, from *model.InventoryItem, x, y, rot int) (*model.RoomItem, error) {
+
+	r.placeMu.Lock()
+	defer r.placeMu.Unlock()
+
+	i := Placed(from)
+	z, err := r.CanPlace(i, x, y, rot)
+	if err != nil {
+		return nil, err
+	}
+
+	i.RoomID, i.X, i.Y, i.Z, i.Rotation = r.Id, x, y, z, rot
+	if err := place(ctx, db, from, i); err != nil {
+		return nil, err
+	}
+
+	r.addFloorItem(i)
+	return i, nil
+
+}
+
+// PlaceWallItem places an item of the user inventory on the room walls, notifying the players in-game.
+func (r *Room) PlaceWallItem(ctx context.Context, db *gorm.DB, from *model.InventoryItem, pos string) (*model.RoomItem, error) {
+
+	if !wallPosition.MatchString(pos) {
+		return nil, ErrPlacementWall
+	}
+
+	i := Placed(from)
+	i.RoomID, i.WallPosition = r.Id, pos
+	if err := place(ctx, db, from, i); err != nil {
+		return nil, err
+	}
+
+	r.addWallItem(i)
+	return i, nil
+
+}
+
+// MoveFloorItem moves or rotates an item placed on the room tiles, notifying the players in-game.
+func (r *Room) MoveFloorItem(ctx context.Context, db *gorm.DB, i *model.RoomItem, x, y, rot int) error {
+
+	r.placeMu.Lock()
+	defer r.placeMu.Unlock()
+
+	// Items holding others would leave them floating at their height.
+	if r.Holds(i) {
+		return ErrPlacementBelow
+	}
+
+	z, err := r.CanPlace(i, x, y, rot)
+	if err != nil {
+		return err
+	}
+
+	previous := *i
+	moved := *i
+	moved.X, moved.Y, moved.Z, moved.Rotation = x, y, z, rot
+	if err := r.saveItem(ctx, db, &moved); err != nil {
+		return err
+	}
+
+	r.setItem(&moved)
+	r.Broadcast(&item.FloorItemUpdatePacket{Item: EncodeFloorItem(&moved)})
+	r.restack(&previous, &moved)
+	return nil
+
+}
+
+// MoveWallItem moves an item placed on the room walls, notifying the players in-game.
+func (r *Room) MoveWallItem(ctx context.Context, db *gorm.DB, i *model.RoomItem, pos string) error {
+
+	if !wallPosition.MatchString(pos) {
+		return ErrPlacementWall
+	}
+
+	moved := *i
+	moved.WallPosition = pos
+	if err := r.saveItem(ctx, db, &moved); err != nil {
+		return err
+	}
+
+	r.setItem(&moved)
+	r.Broadcast(&item.WallItemUpdatePacket{Item: EncodeWallItem(&moved)})
+	return nil
+
+}
+
+// Pickup removes an item from the room back to the inventory of its owner, notifying the players in-game.
+func (r *Room) Pickup(ctx context.Context, db *gorm.DB, i *model.RoomItem, picker uint) (*model.InventoryItem, error) {
+
+	r.placeMu.Lock()
+	defer r.placeMu.Unlock()
+
+	// Items holding others would leave them floating at their height.
+	if i.Definition.Type == model.FloorItem && r.Holds(i) {
+		return nil, ErrPlacementBelow
+	}
+
+	kept := Kept(i)
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Unscoped().Where("id = ? AND room_id = ?", i.ID, r.Id).Delete(&model.RoomItem{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrItemNotFound
+		}
+		return tx.Omit(clause.Associations).Create(kept).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.removeItem(i, picker)
+	return kept, nil
+
+}
+
+// place moves an item from the inventory of its owner to the room, unless it was already taken out.
+func place(ctx context.Context, db *gorm.DB, from *model.InventoryItem, i *model.RoomItem) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Unscoped().Where("id = ? AND user_id = ?", from.ID, from.UserID).Delete(&model.InventoryItem{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrItemNotFound
+		}
+		return tx.Omit(clause.Associations).Create(i).Error
+	})
+}
+
+// addFloorItem stores a floor item as placed, stacking it on the tiles.
+func (r *Room) addFloorItem(i *model.RoomItem) {
+	r.setItem(i)
+	r.Broadcast(&item.FloorItemAddPacket{Item: EncodeFloorItem(i), OwnerName: i.User.Username})
+	r.restack(i)
+}
+
+// addWallItem stores a wall item as placed.
+func (r *Room) addWallItem(i *model.RoomItem) {
+	r.setItem(i)
+	r.Broadcast(&item.WallItemAddPacket{Item: EncodeWallItem(i), OwnerName: i.User.Username})
+}
+
+// removeItem removes a placed item, restoring the tiles it covered.
+func (r *Room) removeItem(i *model.RoomItem, picker uint) {
+
+	r.itemMu.Lock()
+	delete(r.items, i.ID)
+	r.itemMu.Unlock()
+
+	if i.Definition.Type == model.WallItem {
+		r.Broadcast(&item.WallItemRemovePacket{ItemId: int32(i.ID), Picker: int32(picker)})
+		return
+	}
+
+	r.Broadcast(&item.FloorItemRemovePacket{ItemId: int32(i.ID), Picker: int32(picker)})
+	r.restack(i)
+
+}
+
+// saveItem persists the position of a placed item.
+func (r *Room) saveItem(ctx context.Context, db *gorm.DB, i *model.RoomItem) error {
+	return db.WithContext(ctx).Model(&model.RoomItem{}).Where("id = ?", i.ID).Updates(map[string]interface{}{
+		"x":             i.X,
+		"y":             i.Y,
+		"z":             i.Z,
+		"rotation":      i.Rotation,
+		"wall_position": i.WallPosition,
+	}).Error
+}
+
+// setItem stores an item as placed in the room.
+func (r *Room) setItem(i *model.RoomItem) {
+	r.itemMu.Lock()
+	r.items[i.ID] = i
+	r.itemMu.Unlock()
+}
+
+// restack recomputes the tiles covered by the floor items, sending their new heights.
+func (r *Room) restack(items ...*model.RoomItem) {
+
+	r.mu.Lock()
+	seen := make(map[[2]int]bool)
+	var tiles []message.TileHeight
+	for _, i := range items {
+		w, l := Size(i)
+		for x := i.X; x < i.X+w; x++ {
+			for y := i.Y; y < i.Y+l; y++ {
+				if seen[[2]int{x, y}] || !r.l.TileExists(x, y) {
+					continue
+				}
+				seen[[2]int{x, y}] = true
+				r.stack(x, y)
+				tiles = append(tiles, message.TileHeight{X: byte(x), Y: byte(y), Height: int16(r.l.GetTile(x, y).RelativeHeight())})
+			}
+		}
+	}
+	r.mu.Unlock()
+
+	if len(tiles) > 0 {
+		r.Broadcast(&message.HeightMapUpdatePacket{Tiles: tiles})
+	}
+
+}
@@ -0,0 +1,140 @@
+package room
+
+import (
+	"context"
+	"pixels-emulator/core/model"
+	"pixels-emulator/room/path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// placementRoom creates a room with a flat 4x4 layout and the door at 0,0.
+func placementRoom(t *testing.T, heights string) *Room {
+	l, err := path.NewLayout(&model.HeightMap{Heightmap: heights, DoorX: 0, DoorY: 0, DoorDirection: 2})
+	assert.NoError(t, err)
+	return &Room{Id: 1, l: l, items: make(map[uint]*model.RoomItem)}
+}
+
+// TestRoom_CanPlace verifies the footprint of the items is validated against the tiles.
+func TestRoom_CanPlace(t *testing.T) {
+	r := placementRoom(t, "0000\\r\\n0000\\r\\n0000\\r\\n0002")
+	table := furni(1, 0, 0, 0, 0, model.ItemDefinition{Width: 2, Length: 1, StackHeight: 0.7, AllowStack: true})
+	chair := furni(2, 0, 0, 0, 0, model.ItemDefinition{StackHeight: 1, AllowSit: true})
+
+	_, err := r.CanPlace(table, 3, 1, 0)
+	assert.ErrorIs(t, err, ErrPlacementTile)
+
+	for _, rot := range []int{-2, 1, 3, 8} {
+		_, err = r.CanPlace(table, 1, 1, rot)
+		assert.ErrorIs(t, err, ErrPlacementRotate)
+	}
+
+	_, err = r.CanPlace(table, 0, 0, 0)
+	assert.ErrorIs(t, err, ErrPlacementDoor)
+
+	_, err = r.CanPlace(table, 2, 3, 0)
+	assert.ErrorIs(t, err, ErrPlacementHeight, "items can not bridge uneven tiles")
+
+	r.l.GetTile(1, 2).AddUnit("1")
+	_, err = r.CanPlace(table, 1, 2, 0)
+	assert.ErrorIs(t, err, ErrPlacementUnit)
+	z, err := r.CanPlace(chair, 1, 2, 0)
+	assert.NoError(t, err, "units can stand over sittable items")
+	assert.Equal(t, 0.0, z)
+	r.l.GetTile(1, 2).RemoveUnit("1")
+
+	table.X, table.Y = 1, 1
+	r.items[table.ID] = table
+	r.stackAll()
+
+	z, err = r.CanPlace(chair, 2, 1, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.7, z)
+
+	chair.X, chair.Y, chair.Z = 2, 1, z
+	r.items[chair.ID] = chair
+	r.stackAll()
+
+	_, err = r.CanPlace(furni(3, 0, 0, 0, 0, model.ItemDefinition{}), 2, 1, 0)
+	assert.ErrorIs(t, err, ErrPlacementStack)
+
+	z, err = r.CanPlace(table, 1, 1, int(path.East))
+	assert.NoError(t, err, "items are not stacked over themselves")
+	assert.Equal(t, 0.0, z)
+}
+
+// TestRoom_PlaceFloorItem verifies placed items are stored and stacked on the tiles.
+// Moving items between the inventories and the rooms needs a transaction, so the
+// placed items are added as if already persisted.
+func TestRoom_PlaceFloorItem(t *testing.T) {
+	db, err := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+	assert.NoError(t, err)
+
+	r := placementRoom(t, "0000\\r\\n0000\\r\\n0000")
+	table := furni(1, 2, 2, 0, 0, model.ItemDefinition{StackHeight: 0.5, AllowStack: true})
+
+	r.addFloorItem(table)
+	assert.Equal(t, 0.5, r.l.GetTile(2, 2).Height())
+	assert.Equal(t, path.Status(path.Blocked), r.l.GetTile(2, 2).State)
+
+	moved, err := r.Item(1)
+	assert.NoError(t, err)
+	assert.NoError(t, r.MoveFloorItem(context.Background(), db, moved, 3, 2, 0))
+	assert.Equal(t, 0.0, r.l.GetTile(2, 2).Height())
+	assert.Equal(t, 0.5, r.l.GetTile(3, 2).Height())
+
+	moved, _ = r.Item(1)
+	r.removeItem(moved, 1)
+	assert.Equal(t, path.Status(path.Open), r.l.GetTile(3, 2).State)
+	_, err = r.Item(1)
+	assert.ErrorIs(t, err, ErrItemNotFound)
+
+	_, err = r.PlaceWallItem(context.Background(), db, &model.InventoryItem{}, "w=0,2")
+	assert.ErrorIs(t, err, ErrPlacementWall)
+}
+
+// TestRoom_MoveFloorItem_Holding verifies items holding other items can not be moved nor rotated.
+func TestRoom_MoveFloorItem_Holding(t *testing.T) {
+	db, err := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+	assert.NoError(t, err)
+
+	r := placementRoom(t, "0000\\r\\n0000\\r\\n0000")
+	table := furni(1, 1, 1, 0, 0, model.ItemDefinition{Width: 2, Length: 1, StackHeight: 0.7, AllowStack: true})
+	chair := furni(2, 2, 1, 0.7, 0, model.ItemDefinition{StackHeight: 1, AllowSit: true})
+	r.addFloorItem(table)
+	assert.False(t, r.Holds(table))
+
+	r.addFloorItem(chair)
+	assert.True(t, r.Holds(table))
+	assert.False(t, r.Holds(chair))
+
+	assert.ErrorIs(t, r.MoveFloorItem(context.Background(), db, table, 1, 1, int(path.East)), ErrPlacementBelow)
+	assert.ErrorIs(t, r.MoveFloorItem(context.Background(), db, table, 1, 2, 0), ErrPlacementBelow)
+	_, err = r.Pickup(context.Background(), db, table, 1)
+	assert.ErrorIs(t, err, ErrPlacementBelow)
+	assert.NoError(t, r.MoveFloorItem(context.Background(), db, chair, 3, 2, 0))
+
+	table, _ = r.Item(1)
+	assert.False(t, r.Holds(table))
+	assert.NoError(t, r.MoveFloorItem(context.Background(), db, table, 1, 1, int(path.East)))
+}
+
+// TestPlaced verifies items keep their owner, kind and state between the inventories and the rooms.
+func TestPlaced(t *testing.T) {
+	from := &model.InventoryItem{UserID: 3, DefinitionID: 5, ExtraData: "1"}
+	from.ID = 9
+
+	i := Placed(from)
+	assert.Equal(t, uint(0), i.ID, "placed items are stored as new room items")
+	assert.Equal(t, uint(3), i.UserID)
+	assert.Equal(t, uint(5), i.DefinitionID)
+	assert.Equal(t, "1", i.ExtraData)
+
+	kept := Kept(i)
+	assert.Equal(t, uint(3), kept.UserID)
+	assert.Equal(t, uint(5), kept.DefinitionID)
+	assert.Equal(t, "1", kept.ExtraData)
+}
@@ -0,0 +1,149 @@
+package room
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"pixels-emulator/core/model"
+	"pixels-emulator/room/message/promotion"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	PromotionDuration          = 2 * time.Hour // PromotionDuration defines how long a bought promotion lasts.
+	PromotionTitleLength       = 64            // PromotionTitleLength defines the longest promotion title allowed.
+	PromotionDescriptionLength = 255           // PromotionDescriptionLength defines the longest promotion description allowed.
+)
+
+// Promoted checks if a room has a promotion which is not expired.
+func Promoted(r *model.Room, now time.Time) bool {
+	return r.Promotion != nil && r.Promotion.Expires.After(now)
+}
+
+// ValidPromotion checks the texts of a promotion, trimming them.
+func ValidPromotion(title, desc *string) bool {
+	*title = strings.TrimSpace(*title)
+	*desc = strings.TrimSpace(*desc)
+	return *title != "" && utf8.RuneCountInString(*title) <= PromotionTitleLength &&
+		utf8.RuneCountInString(*desc) <= PromotionDescriptionLength
+}
+
+// Promote starts the promotion of a room, replacing any previous one. If extend is set and
+// the room has an active promotion, it lasts PromotionDuration longer keeping its texts instead.
+func Promote(ctx context.Context, db *gorm.DB, room uint, title, desc string, category int, extend bool) (*model.RoomPromotion, error) {
+
+	now := time.Now()
+	p := &model.RoomPromotion{RoomID: room, Title: title, Description: desc, Category: category, Expires: now.Add(PromotionDuration)}
+
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+
+		var active []model.RoomPromotion
+		if err := tx.Where("room_id = ? AND expires > ?", room, now).Limit(1).Find(&active).Error; err != nil {
+			return err
+		}
+
+		if extend && len(active) > 0 {
+			p = &active[0]
+			p.Expires = p.Expires.Add(PromotionDuration)
+			return tx.Model(p).Update("expires", p.Expires).Error
+		}
+
+		if err := tx.Unscoped().Where("room_id = ?", room).Delete(&model.RoomPromotion{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(p).Error
+
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+
+}
+
+// ActivePromotion provides a promotion which is not expired, nil if not found.
+func ActivePromotion(ctx context.Context, db *gorm.DB, id uint) (*model.RoomPromotion, error) {
+	var p []model.RoomPromotion
+	err := db.WithContext(ctx).Where("id = ? AND expires > ?", id, time.Now()).Limit(1).Find(&p).Error
+	if err != nil || len(p) == 0 {
+		return nil, err
+	}
+	return &p[0], nil
+}
+
+// EditPromotion updates the texts of a promotion.
+func EditPromotion(ctx context.Context, db *gorm.DB, p *model.RoomPromotion, title, desc string) error {
+	p.Title = title
+	p.Description = desc
+	return db.WithContext(ctx).Model(p).Updates(map[string]interface{}{"title": title, "description": desc}).Error
+}
+
+// ExpirePromotions removes the expired promotions, providing the rooms they promoted.
+func ExpirePromotions(ctx context.Context, db *gorm.DB) ([]uint, error) {
+
+	var rooms []uint
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Model(&model.RoomPromotion{}).Where("expires <= ?", now).Pluck("room_id", &rooms).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("expires <= ?", now).Delete(&model.RoomPromotion{}).Error
+	})
+
+	return rooms, err
+
+}
+
+// SetPromotion replaces the promotion of the loaded room, notifying the players in-game.
+func (r *Room) SetPromotion(p *model.RoomPromotion) {
+	var d model.Room
+	r.update(func(live *model.Room) {
+		live.Promotion = p
+		d = *live
+	})
+	r.Broadcast(EncodePromotion(&d, time.Now()))
+}
+
+// ExpirePromotion removes the promotion of the loaded room if expired, notifying the
+// players in-game. Promotions bought meanwhile are kept. Returns true if removed.
+func (r *Room) ExpirePromotion(now time.Time) bool {
+	var d model.Room
+	expired := false
+	r.update(func(live *model.Room) {
+		if live.Promotion != nil && !Promoted(live, now) {
+			live.Promotion = nil
+			expired = true
+		}
+		d = *live
+	})
+	if expired {
+		r.Broadcast(EncodePromotion(&d, now))
+	}
+	return expired
+}
+
+// EncodePromotion creates a protocol version of the active room promotion, or
+// the empty event if the room is not promoted.
+func EncodePromotion(r *model.Room, now time.Time) *promotion.RoomEventPacket {
+
+	if !Promoted(r, now) {
+		return &promotion.RoomEventPacket{Ad: -1, OwnerId: -1}
+	}
+
+	p := r.Promotion
+	return &promotion.RoomEventPacket{
+		Ad:          int32(p.ID),
+		OwnerId:     int32(r.OwnerID),
+		OwnerName:   r.Owner.Username,
+		RoomId:      int32(r.ID),
+		Type:        1, // Nitro only knows room events.
+		Title:       p.Title,
+		Description: p.Description,
+		Elapsed:     int32(now.Sub(p.CreatedAt).Minutes()),
+		Remaining:   int32(p.Expires.Sub(now).Minutes()),
+		Category:    int32(p.Category),
+	}
+
+}
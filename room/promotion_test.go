@@ -0,0 +1,66 @@
+package room
+
+import (
+	"pixels-emulator/core/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestValidPromotion verifies promotion texts are trimmed and bounded.
+func TestValidPromotion(t *testing.T) {
+	title, desc := "  Party ", " Everyone is welcome "
+	assert.True(t, ValidPromotion(&title, &desc))
+	assert.Equal(t, "Party", title)
+	assert.Equal(t, "Everyone is welcome", desc)
+
+	title = " "
+	assert.False(t, ValidPromotion(&title, &desc))
+}
+
+// TestEncodePromotion verifies expired promotions are encoded as the empty event.
+func TestEncodePromotion(t *testing.T) {
+	now := time.Now()
+	r := &model.Room{OwnerID: 2, Owner: model.User{Username: "john"}}
+	r.ID = 5
+
+	assert.Equal(t, int32(-1), EncodePromotion(r, now).Ad)
+
+	r.Promotion = &model.RoomPromotion{Title: "Party", Category: 3, Expires: now.Add(-time.Minute)}
+	assert.Equal(t, int32(-1), EncodePromotion(r, now).Ad)
+	assert.Empty(t, EncodeRoom(r, nil).PromotionTitle)
+
+	r.Promotion.ID = 9
+	r.Promotion.CreatedAt = now.Add(-30 * time.Minute)
+	r.Promotion.Expires = now.Add(90 * time.Minute)
+
+	pck := EncodePromotion(r, now)
+	assert.Equal(t, int32(9), pck.Ad)
+	assert.Equal(t, int32(5), pck.RoomId)
+	assert.Equal(t, "john", pck.OwnerName)
+	assert.Equal(t, int32(30), pck.Elapsed)
+	assert.Equal(t, int32(90), pck.Remaining)
+	assert.Equal(t, int32(3), pck.Category)
+
+	enc := EncodeRoom(r, nil)
+	assert.Equal(t, "Party", enc.PromotionTitle)
+	assert.True(t, enc.FeaturedPromotion)
+	assert.InDelta(t, 90, enc.PromotionTime, 1)
+}
+
+// TestRoom_ExpirePromotion verifies only expired promotions are removed, keeping the ones bought meanwhile.
+func TestRoom_ExpirePromotion(t *testing.T) {
+	now := time.Now()
+	r := testRoom(t)
+	p := testPlayer(1)
+	r.Players[p.Id] = p
+
+	r.data.Promotion = &model.RoomPromotion{Title: "Party", Expires: now.Add(PromotionDuration)}
+	assert.False(t, r.ExpirePromotion(now), "promotions bought meanwhile are kept")
+	assert.NotNil(t, r.Data().Promotion)
+
+	assert.True(t, r.ExpirePromotion(now.Add(PromotionDuration)))
+	assert.Nil(t, r.Data().Promotion)
+	assert.False(t, r.ExpirePromotion(now.Add(PromotionDuration)))
+}
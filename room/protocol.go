@@ -43,6 +43,13 @@ func SendHeightMapPackets(conn protocol.Connection, h int32, l *path.Layout) {
 
 }
 
+// SendHeightMap sends the current layout of the room to a connection.
+func (r *Room) SendHeightMap(conn protocol.Connection) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	SendHeightMapPackets(conn, int32(r.Data().Configuration.WallHeight), r.l)
+}
+
 // SendUnitSyncPacket sends the essential unit data and position to the target player about a group of units.
 func SendUnitSyncPacket(origin []*user.Player, target *user.Player) error {
 
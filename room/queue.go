@@ -0,0 +1,47 @@
+package room
+
+import (
+	"pixels-emulator/room/message"
+	"pixels-emulator/user"
+)
+
+// Full checks if the room reached the maximum amount of users defined on its data.
+// A non-positive maximum is considered as unlimited.
+func (r *Room) Full() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.full()
+}
+
+// full checks the room capacity. The room lock must be held.
+func (r *Room) full() bool {
+	limit := r.Data().UsersMax
+	return limit > 0 && len(r.Players)+len(r.Transitioning) >= limit
+}
+
+// enqueue places a player at the end of the room queue unless already enqueued,
+// notifying its current position. The room lock must be held.
+func (r *Room) enqueue(p *user.Player) {
+	if r.Queue.Contains(p.Id) {
+		return
+	}
+	r.Queue.Enqueue(p.Id, p)
+	r.emptySince.Store(0)
+	p.Conn().SendPacket(&message.RoomQueueStatusPacket{Room: int32(r.Id), Position: int32(r.Queue.Position(p.Id))})
+}
+
+// Promote opens the room for the enqueued players while there is
+// capacity available, notifying the remaining ones of their new position.
+func (r *Room) Promote() {
+
+	for _, p := range r.Queue.Values() {
+		if !r.Open(p, nil, true) || r.Queue.Contains(p.Id) {
+			break
+		}
+	}
+
+	for _, p := range r.Queue.Values() {
+		p.Conn().SendPacket(&message.RoomQueueStatusPacket{Room: int32(r.Id), Position: int32(r.Queue.Position(p.Id))})
+	}
+
+}
@@ -0,0 +1,69 @@
+package room
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"pixels-emulator/core/database"
+	mockdb "pixels-emulator/core/database/mock"
+	eventMock "pixels-emulator/core/event/mock"
+	"pixels-emulator/core/model"
+	protocolMock "pixels-emulator/core/protocol/mock"
+	"pixels-emulator/core/util"
+	"pixels-emulator/room/event"
+	"pixels-emulator/user"
+)
+
+// admittedPlayer creates a player whose record can be encoded as a room unit a few times.
+func admittedPlayer(id uint, reads int) *user.Player {
+	u := &model.User{BaseModel: database.BaseModel{ID: id}, Username: "user" + strconv.Itoa(int(id))}
+	svc := &mockdb.ModelServiceMock[model.User]{}
+	for range reads {
+		svc.On("Get", mock.Anything, id).Return(util.MockAsyncResponse(u, nil)).Once()
+	}
+
+	conn := &protocolMock.MockConnection{}
+	conn.On("SendPacket", mock.Anything).Return()
+	conn.On("SendRaw", mock.Anything, mock.Anything, mock.Anything).Return()
+	return user.Load(u, conn, nil, svc)
+}
+
+// TestRoom_Admission verifies concurrent players can not exceed the room capacity,
+// the remaining ones entering once promoted from the queue.
+func TestRoom_Admission(t *testing.T) {
+	em := &eventMock.MockEventManager{}
+	em.On("Fire", event.RoomEnterEventName, mock.Anything).Return()
+
+	r := testRoom(t)
+	r.em = em
+	r.data.UsersMax = 2
+
+	players := make([]*user.Player, 6)
+	for n := range players {
+		players[n] = admittedPlayer(uint(n+1), len(players))
+	}
+
+	var wg sync.WaitGroup
+	for _, p := range players {
+		wg.Add(1)
+		go func(p *user.Player) {
+			defer wg.Done()
+			assert.True(t, r.Open(p, nil, true))
+		}(p)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 2, r.Population())
+	assert.Equal(t, 4, r.Queue.Size())
+
+	left := r.Online()[0]
+	r.Clear(left.Id)
+	r.Promote()
+
+	assert.Equal(t, 2, r.Population())
+	assert.Equal(t, 3, r.Queue.Size())
+	em.AssertNumberOfCalls(t, "Fire", 3)
+}
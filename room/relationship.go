@@ -6,6 +6,7 @@ import (
 	"pixels-emulator/core/database"
 	"pixels-emulator/core/model"
 	"pixels-emulator/role"
+	"pixels-emulator/room/restriction"
 )
 
 // Relationship defines possible relationship types
@@ -22,7 +23,8 @@ const (
 const AccessRoomPermissions = "pixels.room.access"
 const OwnerRoomPermissions = "pixels.room.master"
 
-// VerifyUserRoomRelationship verifies
+// VerifyUserRoomRelationship verifies the relationship of a user with a room.
+// Owners, rights holders and users with access permissions are never restricted.
 func VerifyUserRoomRelationship(ctx context.Context, db *gorm.DB, room model.Room, user model.User) (Relationship, error) {
 
 	if role.HasPermission(user, OwnerRoomPermissions) || room.OwnerID == user.ID {
@@ -45,7 +47,12 @@ func VerifyUserRoomRelationship(ctx context.Context, db *gorm.DB, room model.Roo
 		return Access, nil
 	}
 
-	if false { // TODO: Room restriction system
+	banned, err := restriction.Banned(ctx, db, room.ID, user.ID)
+	if err != nil {
+		return Restriction, err
+	}
+
+	if banned {
 		return Restriction, nil
 	}
 
@@ -0,0 +1,109 @@
+package restriction
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"pixels-emulator/core/model"
+	"time"
+)
+
+const (
+	BanHour      = "RWUAM_BAN_USER_HOUR" // BanHour is the Nitro ban type lasting an hour.
+	BanDay       = "RWUAM_BAN_USER_DAY"  // BanDay is the Nitro ban type lasting a day.
+	BanPermanent = "RWUAM_BAN_USER_PERM" // BanPermanent is the Nitro ban type never lifted.
+)
+
+// Permanent defines the duration of the restrictions which are never lifted.
+const Permanent = 100 * 365 * 24 * time.Hour
+
+// BanDuration provides the duration of a Nitro ban type, permanent if unknown.
+func BanDuration(t string) time.Duration {
+	switch t {
+	case BanHour:
+		return time.Hour
+	case BanDay:
+		return 24 * time.Hour
+	default:
+		return Permanent
+	}
+}
+
+// MaxMute defines the longest mute a room controller can apply.
+const MaxMute = 24 * time.Hour
+
+// MuteDuration provides the duration of a mute in minutes, kept between a minute and MaxMute.
+func MuteDuration(minutes int32) time.Duration {
+	return min(max(time.Duration(minutes), 1)*time.Minute, MaxMute)
+}
+
+// Ban prohibits a user to enter a room for the duration, replacing any previous ban.
+func Ban(ctx context.Context, db *gorm.DB, room, user uint, d time.Duration) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := scope(tx, room, user).Unscoped().Delete(&model.RoomBan{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.RoomBan{RoomID: room, UserID: user, Expires: time.Now().Add(d)}).Error
+	})
+}
+
+// Unban lifts the ban of a user in a room.
+func Unban(ctx context.Context, db *gorm.DB, room, user uint) error {
+	return scope(db.WithContext(ctx), room, user).Unscoped().Delete(&model.RoomBan{}).Error
+}
+
+// Banned checks if a user has a ban in a room which is not expired.
+func Banned(ctx context.Context, db *gorm.DB, room, user uint) (bool, error) {
+	var n int64
+	err := scope(db.WithContext(ctx), room, user).Model(&model.RoomBan{}).Where("expires > ?", time.Now()).Count(&n).Error
+	return n > 0, err
+}
+
+// Bans provides the bans of a room which are not expired, with their users.
+func Bans(ctx context.Context, db *gorm.DB, room uint) ([]model.RoomBan, error) {
+	var bans []model.RoomBan
+	err := db.WithContext(ctx).Preload("User").Where("room_id = ? AND expires > ?", room, time.Now()).Find(&bans).Error
+	return bans, err
+}
+
+// Mute prohibits a user to chat in a room for the duration, replacing any previous mute.
+// Returns the moment when the mute is lifted.
+func Mute(ctx context.Context, db *gorm.DB, room, user uint, d time.Duration) (time.Time, error) {
+	until := time.Now().Add(d)
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := scope(tx, room, user).Unscoped().Delete(&model.RoomMute{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&model.RoomMute{RoomID: room, UserID: user, Expires: until}).Error
+	})
+	return until, err
+}
+
+// MutedUntil provides the moment when the mute of a user in a room is lifted, zero if not muted.
+func MutedUntil(ctx context.Context, db *gorm.DB, room, user uint) (time.Time, error) {
+	var mutes []model.RoomMute
+	err := scope(db.WithContext(ctx), room, user).Where("expires > ?", time.Now()).Order("expires DESC").Limit(1).Find(&mutes).Error
+	if err != nil || len(mutes) == 0 {
+		return time.Time{}, err
+	}
+	return mutes[0].Expires, nil
+}
+
+// Purge permanently removes the expired bans and mutes.
+func Purge(ctx context.Context, db *gorm.DB) (int64, error) {
+
+	now := time.Now()
+
+	bRes := db.WithContext(ctx).Unscoped().Where("expires <= ?", now).Delete(&model.RoomBan{})
+	if bRes.Error != nil {
+		return 0, bRes.Error
+	}
+
+	mRes := db.WithContext(ctx).Unscoped().Where("expires <= ?", now).Delete(&model.RoomMute{})
+	return bRes.RowsAffected + mRes.RowsAffected, mRes.Error
+
+}
+
+// scope filters the restrictions of a user in a room.
+func scope(db *gorm.DB, room, user uint) *gorm.DB {
+	return db.Where("room_id = ? AND user_id = ?", room, user)
+}
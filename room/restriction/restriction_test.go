@@ -0,0 +1,72 @@
+package restriction
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"pixels-emulator/core/model"
+)
+
+// dryRun creates a database session which only builds the statements.
+func dryRun(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	assert.NoError(t, err)
+	return db
+}
+
+// TestBanDuration verifies the Nitro ban types durations.
+func TestBanDuration(t *testing.T) {
+	assert.Equal(t, time.Hour, BanDuration(BanHour))
+	assert.Equal(t, 24*time.Hour, BanDuration(BanDay))
+	assert.Equal(t, Permanent, BanDuration(BanPermanent))
+	assert.Equal(t, Permanent, BanDuration("unknown"))
+}
+
+// TestMuteDuration verifies the mutes are kept between a minute and the maximum.
+func TestMuteDuration(t *testing.T) {
+	assert.Equal(t, 5*time.Minute, MuteDuration(5))
+	assert.Equal(t, time.Minute, MuteDuration(0))
+	assert.Equal(t, time.Minute, MuteDuration(-30))
+	assert.Equal(t, MaxMute, MuteDuration(1<<30))
+}
+
+// TestScope verifies the restrictions are filtered by room and user.
+func TestScope(t *testing.T) {
+	db := dryRun(t)
+
+	var bans []model.RoomBan
+	sql := scope(db, 1, 2).Where("expires > ?", time.Now()).Find(&bans).Statement.SQL.String()
+	assert.Contains(t, sql, "room_id = ? AND user_id = ?")
+	assert.Contains(t, sql, "expires > ?")
+}
+
+// TestMutedUntil_NotMuted verifies a zero time is provided without mutes.
+func TestMutedUntil_NotMuted(t *testing.T) {
+	until, err := MutedUntil(context.Background(), dryRun(t), 1, 2)
+	assert.NoError(t, err)
+	assert.True(t, until.IsZero())
+}
+
+// TestPurge verifies the expired bans and mutes are removed from their own tables.
+func TestPurge(t *testing.T) {
+	db := dryRun(t)
+
+	var tables, sqls []string
+	err := db.Callback().Delete().After("gorm:delete").Register("test:purge", func(tx *gorm.DB) {
+		tables = append(tables, tx.Statement.Table)
+		sqls = append(sqls, tx.Statement.SQL.String())
+	})
+	assert.NoError(t, err)
+
+	_, err = Purge(context.Background(), db.Session(&gorm.Session{SkipDefaultTransaction: true}))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"room_bans", "room_mutes"}, tables)
+	for _, sql := range sqls {
+		assert.Equal(t, 1, strings.Count(sql, "expires <= ?"))
+	}
+}
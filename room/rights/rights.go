@@ -0,0 +1,50 @@
+package rights
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"pixels-emulator/core/model"
+)
+
+// Grant gives rights over a room to a user. Returns false if the user already had them.
+func Grant(ctx context.Context, db *gorm.DB, room, user uint) (bool, error) {
+	res := db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&model.RoomPermission{RoomID: room, UserID: user})
+	return res.RowsAffected > 0, res.Error
+}
+
+// Revoke removes the rights over a room of the users. Returns the users whose rights
+// were removed, skipping those without rights. The rights are locked until removed,
+// so concurrent revocations do not report the same users.
+func Revoke(ctx context.Context, db *gorm.DB, room uint, users ...uint) ([]uint, error) {
+
+	if len(users) == 0 {
+		return nil, nil
+	}
+
+	var revoked []uint
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+
+		err := tx.Model(&model.RoomPermission{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("room_id = ? AND user_id IN ?", room, users).Pluck("user_id", &revoked).Error
+		if err != nil || len(revoked) == 0 {
+			return err
+		}
+
+		return tx.Unscoped().Where("room_id = ? AND user_id IN ?", room, revoked).Delete(&model.RoomPermission{}).Error
+
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return revoked, nil
+
+}
+
+// Holders provides the rights of a room, with their users.
+func Holders(ctx context.Context, db *gorm.DB, room uint) ([]model.RoomPermission, error) {
+	var perms []model.RoomPermission
+	err := db.WithContext(ctx).Preload("User").Where("room_id = ?", room).Find(&perms).Error
+	return perms, err
+}
@@ -0,0 +1,36 @@
+package rights
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"pixels-emulator/core/model"
+)
+
+// dryRun creates a database session which only builds the statements.
+func dryRun(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+	assert.NoError(t, err)
+	return db
+}
+
+// TestRevoke_Empty verifies no statement is executed without users.
+func TestRevoke_Empty(t *testing.T) {
+	revoked, err := Revoke(context.Background(), nil, 1)
+	assert.NoError(t, err)
+	assert.Empty(t, revoked)
+}
+
+// TestGrant verifies the rights are created ignoring duplicates.
+func TestGrant(t *testing.T) {
+	db := dryRun(t)
+	_, err := Grant(context.Background(), db, 1, 2)
+	assert.NoError(t, err)
+
+	sql := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.RoomPermission{RoomID: 1, UserID: 2}).Statement.SQL.String()
+	assert.Contains(t, sql, "ON DUPLICATE KEY UPDATE")
+}
@@ -5,74 +5,191 @@ import (
 	"pixels-emulator/core/cycle"
 	"pixels-emulator/core/event"
 	"pixels-emulator/core/model"
+	"pixels-emulator/core/protocol"
 	"pixels-emulator/core/util"
 	ev "pixels-emulator/room/event"
 	"pixels-emulator/room/path"
 	"pixels-emulator/user"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 // Room defines an ephemeral room which will be
 // stored in memory for in-game modifications.
 type Room struct {
-	cycle.Cycleable                         // Cycleable as the room need to tick every certain amount of time.
-	Id              uint                    // Id is the identifier of the room
-	Transitioning   map[string]*user.Player // Transitioning is the map of users in process of room rendering.
-	Data            model.Room              // Data of retrieved from the database when room was loaded.
-	Players         map[string]*user.Player // Players are the connected players in-game.
-	Queue           *util.Queue[string]     // Queue of users pending to enter
-	lData           model.HeightMap         // lData defines the room layout data on load.
-	l               *path.Layout            // l defines the generated ephemeral layout.
-	stamp           int64                   // stamp is the last timestamp from cycle
-	ready           bool                    // ready defines if room finished loading cycle
-	em              event.Manager           // em is an event manager to handle further events.
-	logger          *zap.Logger             // logger to logging tools.
+	cycle.Cycleable                           // Cycleable as the room need to tick every certain amount of time.
+	Id              uint                      // Id is the identifier of the room
+	Transitioning   map[string]*user.Player   // Transitioning is the map of users in process of room rendering.
+	data            model.Room                // data of retrieved from the database when room was loaded.
+	dataMu          sync.RWMutex              // dataMu guards data, as it is read by the handlers while the owner edits it.
+	saveMu          sync.Mutex                // saveMu serializes the writes of data, so the stored and the live data match.
+	Players         map[string]*user.Player   // Players are the connected players in-game.
+	mu              sync.RWMutex              // mu guards the players, their units and the layout tiles, as they are modified by the cycle and the handlers.
+	Queue           *util.Queue[*user.Player] // Queue of users pending to enter
+	Flood           *util.FloodLimiter        // Flood limits the chat messages of the players.
+	lData           model.HeightMap           // lData defines the room layout data on load.
+	l               *path.Layout              // l defines the generated ephemeral layout.
+	stamp           atomic.Int64              // stamp is the last timestamp from cycle
+	emptySince      atomic.Int64              // emptySince is the timestamp when the room became empty, zero if not empty.
+	dirty           atomic.Bool               // dirty defines if in-memory state must be persisted.
+	rings           map[string]*Ring          // rings are the pending doorbell requests.
+	ringMu          sync.Mutex                // ringMu guards rings, as they are resolved asynchronously.
+	mutes           sync.Map                  // mutes are the chat prohibitions of the players until their expiration.
+	items           map[uint]*model.RoomItem  // items are the furniture placed in the room.
+	itemMu          sync.RWMutex              // itemMu guards items, as they are modified by the players.
+	placeMu         sync.Mutex                // placeMu serializes the item placements, as they are validated against the tiles.
+	ready           bool                      // ready defines if room finished loading cycle
+	unloaded        bool                      // unloaded defines if the room was removed from the store, refusing new players.
+	em              event.Manager             // em is an event manager to handle further events.
+	tk              cycle.Ticker              // tk is the cycle engine which ticks the room.
+	logger          *zap.Logger               // logger to logging tools.
 }
 
-func (r *Room) Cycle() {
+// CycleTime defines the interval of the room cycles in cycle.TimeUnit.
+const CycleTime byte = 5
 
+// Cycle performs the periodic room updates.
+func (r *Room) Cycle() {
+	r.walk()
 }
 
+// Time provides the interval of the room cycles in cycle.TimeUnit.
 func (r *Room) Time() byte {
-	return 0
+	return CycleTime
 }
 
+// Stamp provides the last timestamp in milliseconds when the room cycled.
 func (r *Room) Stamp() int64 {
-	return r.stamp
+	return r.stamp.Load()
 }
 
+// SetStamp updates the cycle timestamp to the current time.
 func (r *Room) SetStamp() {
-	r.stamp = time.Now().UnixMilli()
+	r.stamp.Store(time.Now().UnixMilli())
+}
+
+// Data provides a copy of the room data, so it can be read while the room is edited.
+func (r *Room) Data() model.Room {
+	r.dataMu.RLock()
+	defer r.dataMu.RUnlock()
+	return r.data
+}
+
+// update modifies the room data.
+func (r *Room) update(f func(d *model.Room)) {
+	r.dataMu.Lock()
+	defer r.dataMu.Unlock()
+	f(&r.data)
 }
 
 func (r *Room) Ready() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	return r.ready
 }
 
 func (r *Room) IsOnline(player *user.Player) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	_, ex := r.Players[player.Id]
 	return ex
 }
 
 func (r *Room) IsTransitioning(player *user.Player) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	_, ex := r.Transitioning[player.Id]
 	return ex
 }
 
 func (r *Room) Layout() *path.Layout {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
 	return r.l
 }
 
+// Player provides an in-game player.
+func (r *Room) Player(id string) (*user.Player, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	p, ok := r.Players[id]
+	return p, ok
+}
+
+// Online provides the players in-game.
+func (r *Room) Online() []*user.Player {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	res := make([]*user.Player, 0, len(r.Players))
+	for _, p := range r.Players {
+		res = append(res, p)
+	}
+	return res
+}
+
+// Population provides the amount of players in-game.
+func (r *Room) Population() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.Players)
+}
+
+// Broadcast sends a packet to every player in-game.
+func (r *Room) Broadcast(pck protocol.Packet) {
+	for _, p := range r.Online() {
+		p.Conn().SendPacket(pck)
+	}
+}
+
 // Clear removes completely a player from a room.
 func (r *Room) Clear(id string) {
+	r.mu.Lock()
+	if p, ok := r.Players[id]; ok {
+		if t := p.Unit().GetCurrentTile(r.l); t != nil {
+			t.RemoveUnit(id)
+		}
+		p.Unit().Request = nil
+	}
 	delete(r.Transitioning, id)
 	delete(r.Players, id)
+	r.mu.Unlock()
+
 	r.Queue.Remove(id)
+	r.ringMu.Lock()
+	delete(r.rings, id)
+	r.ringMu.Unlock()
+	r.Flood.Forget(id)
+	r.mutes.Delete(id)
+
+	if r.Empty() {
+		r.tk.Unregister(r.key())
+		r.emptySince.CompareAndSwap(0, time.Now().UnixMilli())
+	}
+}
+
+// Empty checks if the room has no players in-game, transitioning or enqueued.
+func (r *Room) Empty() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.empty()
 }
 
-func Load(room *model.Room, logger *zap.Logger, em event.Manager) (*Room, error) {
+// empty checks if the room has no players, the caller must hold the room lock.
+func (r *Room) empty() bool {
+	return len(r.Players) == 0 && len(r.Transitioning) == 0 && r.Queue.Size() == 0
+}
 
-	q := util.NewQueue[string]()
+// key provides the identifier of the room in stores and engines.
+func (r *Room) key() string {
+	return strconv.Itoa(int(r.Id))
+}
+
+// Load creates the ephemeral room from its record and registers it into the cycle engine.
+func Load(room *model.Room, logger *zap.Logger, em event.Manager, tk cycle.Ticker) (*Room, error) {
+
+	q := util.NewQueue[*user.Player]()
 	cRoom := *room
 	l, err := path.NewLayout(&room.Layout)
 
@@ -83,22 +200,35 @@ func Load(room *model.Room, logger *zap.Logger, em event.Manager) (*Room, error)
 	r := &Room{
 		Id:            room.ID,
 		Queue:         q,
-		Data:          cRoom,
-		stamp:         time.Now().UnixMilli(),
+		Flood:         util.NewFloodLimiter(FloodWindow, FloodMute),
+		data:          cRoom,
 		ready:         false,
 		em:            em,
+		tk:            tk,
 		lData:         room.Layout,
 		l:             l,
 		Transitioning: make(map[string]*user.Player),
 		Players:       make(map[string]*user.Player),
+		rings:         make(map[string]*Ring),
+		items:         make(map[uint]*model.RoomItem),
 		logger:        logger,
 	}
+	r.SetStamp()
+	r.emptySince.Store(time.Now().UnixMilli())
+	tk.Register(r.key(), r)
 
 	go func() {
+		r.mu.Lock()
 		r.ready = true
-		em.Fire(ev.RoomOpenEventName, ev.NewRoomOpenEvent(r.Id, 0, make(map[string]string)))
+		transitioning := make([]*user.Player, 0, len(r.Transitioning))
 		for _, p := range r.Transitioning {
-			r.Open(p, nil)
+			transitioning = append(transitioning, p)
+		}
+		r.mu.Unlock()
+
+		em.Fire(ev.RoomOpenEventName, ev.NewRoomOpenEvent(r.Id, 0, make(map[string]string)))
+		for _, p := range transitioning {
+			r.Open(p, nil, false)
 		}
 		zap.L().Debug("Room opened", zap.Uint("identifier", r.Id))
 	}()
@@ -0,0 +1,88 @@
+package room
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+	"pixels-emulator/core/cycle"
+	"pixels-emulator/core/database"
+	"pixels-emulator/core/model"
+	protocolMock "pixels-emulator/core/protocol/mock"
+	"pixels-emulator/core/util"
+	"pixels-emulator/room/message/chat"
+	"pixels-emulator/room/path"
+	"pixels-emulator/user"
+)
+
+// testRoom creates a loaded room with a flat 5x5 layout and the door at 0,0.
+func testRoom(t *testing.T) *Room {
+	l, err := path.NewLayout(&model.HeightMap{Heightmap: "00000\\r\\n00000\\r\\n00000\\r\\n00000\\r\\n00000", DoorX: 0, DoorY: 0, DoorDirection: 2})
+	assert.NoError(t, err)
+	return &Room{
+		Id:            1,
+		l:             l,
+		Queue:         util.NewQueue[*user.Player](),
+		Flood:         util.NewFloodLimiter(FloodWindow, FloodMute),
+		Transitioning: make(map[string]*user.Player),
+		Players:       make(map[string]*user.Player),
+		rings:         make(map[string]*Ring),
+		items:         make(map[uint]*model.RoomItem),
+		tk:            cycle.NewPoolTicker(1, zap.NewNop()),
+		logger:        zap.NewNop(),
+	}
+}
+
+// testPlayer creates a player whose connection accepts any packet.
+func testPlayer(id uint) *user.Player {
+	conn := &protocolMock.MockConnection{}
+	conn.On("SendPacket", mock.Anything).Return()
+	return user.Load(&model.User{BaseModel: database.BaseModel{ID: id}, Username: "user" + strconv.Itoa(int(id))}, conn, nil, nil)
+}
+
+// TestRoom_Concurrent verifies the cycle and the handlers can access the players
+// and the layout at the same time. Run with -race to detect unguarded accesses.
+func TestRoom_Concurrent(t *testing.T) {
+
+	r := testRoom(t)
+
+	var players []*user.Player
+	for n := 1; n <= 4; n++ {
+		p := testPlayer(uint(n))
+		p.Unit().Current = path.NewCoordinate(0, 0, 0, 2)
+		r.l.GetTile(0, 0).AddUnit(p.Id)
+		r.Players[p.Id] = p
+		players = append(players, p)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for n := 0; n < 200; n++ {
+			r.Cycle()
+		}
+	}()
+
+	for i, p := range players {
+		wg.Add(1)
+		go func(i int, p *user.Player) {
+			defer wg.Done()
+			for n := 0; n < 50; n++ {
+				r.Walk(p, (n+i)%5, (n*i)%5)
+				r.Control(p, RightsControl)
+				r.Chat(p, chat.Talk, "hi", 0, nil)
+				_ = r.Population()
+				_ = r.Full()
+			}
+			r.Clear(p.Id)
+		}(i, p)
+	}
+
+	wg.Wait()
+	assert.True(t, r.Empty())
+
+}
@@ -0,0 +1,51 @@
+package scheduler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"pixels-emulator/core/database"
+	"pixels-emulator/core/model"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room/chatlog"
+	"time"
+)
+
+// ScheduleChatLog adds to server scheduling the periodic writing of
+// pending chat logs and the daily purge of logs older than the retention.
+func ScheduleChatLog() {
+
+	sv := server.GetServer()
+	cfg := sv.Config().Chat
+	w := chatlog.GetWriter()
+
+	if cfg.LogFlush > 0 {
+		sv.Scheduler().ScheduleRepeatingTask(time.Duration(cfg.LogFlush)*time.Second, func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			_ = w.Flush(ctx)
+		})
+	}
+
+	if cfg.LogRetention == 0 {
+		return
+	}
+
+	svc := &database.ModelService[model.ChatLog]{DB: sv.Database()}
+	retention := time.Duration(cfg.LogRetention) * 24 * time.Hour
+
+	_, err := sv.Scheduler().ScheduleTask("@daily", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		n, err := chatlog.Purge(ctx, svc, retention)
+		if err != nil {
+			sv.Logger().Error("Error purging chat logs", zap.Error(err))
+			return
+		}
+		sv.Logger().Info("Purged chat logs", zap.Int64("amount", n))
+	})
+
+	if err != nil {
+		sv.Logger().Error("Error scheduling chat log purge", zap.Error(err))
+	}
+
+}
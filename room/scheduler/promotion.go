@@ -0,0 +1,42 @@
+package scheduler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room"
+	"strconv"
+	"time"
+)
+
+// PromotionExpiryRate defines every how long expired room promotions are removed.
+const PromotionExpiryRate = time.Minute
+
+// SchedulePromotionExpiry adds to server scheduling the removal of the expired room
+// promotions, notifying the players in-game of the promoted rooms.
+func SchedulePromotionExpiry() {
+
+	sv := server.GetServer()
+
+	sv.Scheduler().ScheduleRepeatingTask(PromotionExpiryRate, func() {
+
+		ctx, cancel := context.WithTimeout(context.Background(), PromotionExpiryRate)
+		defer cancel()
+
+		rooms, err := room.ExpirePromotions(ctx, sv.Database())
+		if err != nil {
+			sv.Logger().Error("Error expiring room promotions", zap.Error(err))
+			return
+		}
+
+		for _, id := range rooms {
+			if r, err := sv.RoomStore().Records().Read(ctx, strconv.Itoa(int(id))); err == nil && r != nil {
+				r.ExpirePromotion(time.Now())
+			}
+		}
+
+		sv.Logger().Debug("Expired room promotions", zap.Int("amount", len(rooms)))
+
+	})
+
+}
@@ -0,0 +1,30 @@
+package scheduler
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room/restriction"
+	"time"
+)
+
+// RestrictionPurgeRate defines every how long expired bans and mutes are removed.
+const RestrictionPurgeRate = 5 * time.Minute
+
+// ScheduleRestrictionPurge adds to server scheduling the removal of the expired room bans and mutes.
+func ScheduleRestrictionPurge() {
+
+	sv := server.GetServer()
+
+	sv.Scheduler().ScheduleRepeatingTask(RestrictionPurgeRate, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		n, err := restriction.Purge(ctx, sv.Database())
+		if err != nil {
+			sv.Logger().Error("Error purging room restrictions", zap.Error(err))
+			return
+		}
+		sv.Logger().Debug("Purged room restrictions", zap.Int64("amount", n))
+	})
+
+}
@@ -0,0 +1,57 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"go.uber.org/zap"
+	"pixels-emulator/core/server"
+	"pixels-emulator/room"
+	"time"
+)
+
+// UnloadCheckRate defines every how long idle rooms are checked.
+const UnloadCheckRate = 10 * time.Second
+
+// ScheduleUnload adds to server scheduling the unloading of the rooms
+// which stayed empty longer than the configured grace period.
+func ScheduleUnload() {
+
+	sv := server.GetServer()
+	grace := time.Duration(sv.Config().Room.UnloadGrace) * time.Second
+
+	task := func() {
+
+		ctx, cancel := context.WithTimeout(context.Background(), UnloadCheckRate)
+		defer cancel()
+
+		rooms, err := sv.RoomStore().Records().GetAll(ctx)
+		if err != nil {
+			sv.Logger().Error("Error retrieving rooms to unload", zap.Error(err))
+			return
+		}
+
+		now := time.Now()
+		for _, r := range rooms {
+
+			if !r.Empty() || r.Idle(now) < grace {
+				continue
+			}
+
+			err := room.Unload(ctx, sv.RoomStore(), r, sv.Database())
+			if errors.Is(err, room.ErrRoomOccupied) {
+				continue
+			}
+			if err != nil {
+				sv.Logger().Error("Error unloading room", zap.Uint("room", r.Id), zap.Error(err))
+				continue
+			}
+
+			sv.Logger().Debug("Room unloaded", zap.Uint("room", r.Id))
+
+		}
+
+	}
+
+	sv.Scheduler().ScheduleRepeatingTask(UnloadCheckRate, task)
+
+}
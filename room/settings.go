@@ -0,0 +1,177 @@
+package room
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"pixels-emulator/core/model"
+	"pixels-emulator/core/util"
+	"pixels-emulator/room/encode"
+	"pixels-emulator/room/message/settings"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	NameMinLength        = 3   // NameMinLength defines the shortest room name allowed.
+	NameMaxLength        = 60  // NameMaxLength defines the longest room name allowed.
+	DescriptionMaxLength = 255 // DescriptionMaxLength defines the longest room description allowed.
+	MaxTags              = 2   // MaxTags defines the amount of tags a room can have.
+	TagMaxLength         = 20  // TagMaxLength defines the longest room tag allowed.
+	MaxHearingDistance   = 99  // MaxHearingDistance defines the farthest chat hearing distance allowed.
+)
+
+// SettingsError defines the Nitro reasons of rejected room settings.
+type SettingsError int32
+
+const (
+	InvalidDoorMode    SettingsError = 3  // InvalidDoorMode rejects unknown access states.
+	InvalidUserLimit   SettingsError = 4  // InvalidUserLimit rejects users limits out of bounds.
+	InvalidPassword    SettingsError = 5  // InvalidPassword rejects password protection without password.
+	InvalidName        SettingsError = 7  // InvalidName rejects names out of bounds.
+	InvalidDescription SettingsError = 9  // InvalidDescription rejects descriptions out of bounds.
+	InvalidTag         SettingsError = 11 // InvalidTag rejects too many or empty tags.
+	TooLongTag         SettingsError = 13 // TooLongTag rejects tags out of bounds.
+)
+
+// Settings provides the editable settings of the room.
+func (r *Room) Settings() settings.Settings {
+	d := r.Data()
+	c := d.Configuration
+	return settings.Settings{
+		Room:             int32(r.Id),
+		Name:             d.Name,
+		Description:      d.Description,
+		Door:             DoorOf(d.State),
+		UsersMax:         int32(d.UsersMax),
+		Category:         int32(d.CategoryID),
+		Tags:             SplitTags(d.Tags),
+		TradeMode:        TradeOf(c.TradeMode),
+		AllowPets:        c.AllowPets,
+		AllowPetsFeed:    c.AllowPetsFeed,
+		AllowWalkThrough: c.AllowWalkThrough,
+		HideWall:         c.AllowHideWall,
+		WallThickness:    int32(c.WallThickness),
+		FloorThickness:   int32(c.FloorThickness),
+		Moderation: &encode.ModerationRights{
+			Mute: encode.Administrator,
+			Kick: encode.Administrator,
+			Ban:  encode.Administrator,
+		},
+		Chat: EncodeSettings(&c),
+	}
+}
+
+// ValidateSettings checks the requested settings against the room, trimming its texts
+// and normalizing its tags.
+// Returns the Nitro rejection reason and false if the settings can not be applied.
+func (r *Room) ValidateSettings(s *settings.Settings, usersLimit int) (SettingsError, bool) {
+
+	s.Name = strings.TrimSpace(s.Name)
+	s.Description = strings.TrimSpace(s.Description)
+
+	if l := utf8.RuneCountInString(s.Name); l < NameMinLength || l > NameMaxLength {
+		return InvalidName, false
+	}
+
+	if utf8.RuneCountInString(s.Description) > DescriptionMaxLength {
+		return InvalidDescription, false
+	}
+
+	if s.Door < encode.Open || s.Door > encode.Invisible {
+		return InvalidDoorMode, false
+	}
+
+	if s.Door == encode.PasswordProtected && s.Password == "" && r.Data().Password == "" {
+		return InvalidPassword, false
+	}
+
+	if s.UsersMax < 1 || int(s.UsersMax) > usersLimit {
+		return InvalidUserLimit, false
+	}
+
+	for _, t := range s.Tags {
+		t = NormalizeTag(t)
+		if t == "" || strings.Contains(t, TagSeparator) {
+			return InvalidTag, false
+		}
+		if utf8.RuneCountInString(t) > TagMaxLength {
+			return TooLongTag, false
+		}
+	}
+
+	// Tags are stored normalized, so repeated ones only count once.
+	s.Tags = NormalizeTags(s.Tags)
+	if len(s.Tags) > MaxTags {
+		return InvalidTag, false
+	}
+
+	return 0, true
+
+}
+
+// SaveSettings persists validated settings, hashing the password when changed, and
+// updates the room data once stored. Thickness and chat values out of the Nitro
+// bounds are clamped. The room keeps its current settings if they can not be stored.
+func (r *Room) SaveSettings(ctx context.Context, db *gorm.DB, s *settings.Settings) error {
+
+	r.saveMu.Lock()
+	defer r.saveMu.Unlock()
+
+	d := r.Data()
+	if err := ApplySettings(&d, s); err != nil {
+		return err
+	}
+
+	if err := r.save(ctx, db, &d); err != nil {
+		return err
+	}
+
+	r.update(func(live *model.Room) {
+		own(live, d)
+	})
+	return nil
+
+}
+
+// ApplySettings updates a version of the room data with validated settings.
+func ApplySettings(d *model.Room, s *settings.Settings) error {
+
+	if s.Door == encode.PasswordProtected && s.Password != "" {
+		hash, err := util.HashPassword(s.Password)
+		if err != nil {
+			return err
+		}
+		d.Password = hash
+	} else if s.Door != encode.PasswordProtected {
+		d.Password = ""
+	}
+
+	d.Name = s.Name
+	d.Description = s.Description
+	d.State = StateOf(s.Door)
+	d.UsersMax = int(s.UsersMax)
+	d.CategoryID = uint(max(s.Category, 0))
+	d.Tags = JoinTags(s.Tags)
+
+	c := &d.Configuration
+	c.TradeMode = TradeModeOf(s.TradeMode)
+	c.AllowPets = s.AllowPets
+	c.AllowPetsFeed = s.AllowPetsFeed
+	c.AllowWalkThrough = s.AllowWalkThrough
+	c.AllowHideWall = s.HideWall
+	c.WallThickness = float64(clamp(s.WallThickness, -2, 1))
+	c.FloorThickness = float64(clamp(s.FloorThickness, -2, 1))
+	c.ChatMode = int(clamp(int32(s.Chat.Mode), encode.ChatModeFreeFlow, encode.ChatModeLineByLine))
+	c.ChatWeight = int(clamp(int32(s.Chat.Weight), encode.ChatBubbleWidthWide, encode.ChatBubbleWidthThin))
+	c.ChatSpeed = int(clamp(int32(s.Chat.Speed), encode.ChatScrollSpeedFast, encode.ChatScrollSpeedSlow))
+	c.ChatHearingDistance = int(clamp(s.Chat.Distance, 0, MaxHearingDistance))
+	c.ChatProtection = int(clamp(int32(s.Chat.Protection), encode.FloodFilterStrict, encode.FloodFilterLoose))
+
+	return nil
+
+}
+
+// clamp limits a value inside the bounds.
+func clamp(v, mn, mx int32) int32 {
+	return max(mn, min(v, mx))
+}
@@ -0,0 +1,47 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"pixels-emulator/room/encode"
+	"pixels-emulator/room/message/settings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// TestRoom_SaveSettings verifies the settings are only applied once stored, while
+// the room data is read. Run with -race to detect unguarded accesses.
+func TestRoom_SaveSettings(t *testing.T) {
+	db, err := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+	assert.NoError(t, err)
+
+	r := testRoom(t)
+	r.data.Name = "Lobby"
+	s := &settings.Settings{Name: "Party", Door: encode.Open, UsersMax: 10, Chat: &encode.RoomChatSettings{}}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for n := 0; n < 100; n++ {
+			_ = r.Data().Name
+			_ = r.Full()
+		}
+	}()
+	assert.NoError(t, r.SaveSettings(context.Background(), db, s))
+	wg.Wait()
+
+	assert.Equal(t, "Party", r.Data().Name)
+	assert.Equal(t, 10, r.Data().UsersMax)
+
+	assert.NoError(t, db.Callback().Update().Before("gorm:update").Register("test:fail", func(tx *gorm.DB) {
+		_ = tx.AddError(errors.New("database down"))
+	}))
+	s.Name = "Failed"
+	assert.Error(t, r.SaveSettings(context.Background(), db, s))
+	assert.Equal(t, "Party", r.Data().Name, "settings not stored are not applied")
+}
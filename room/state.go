@@ -0,0 +1,90 @@
+package room
+
+import (
+	"pixels-emulator/room/encode"
+	"strings"
+)
+
+const (
+	StateOpen      = "open"               // StateOpen defines rooms accessible to everyone.
+	StateLocked    = "closed"             // StateLocked defines rooms which require ringing the doorbell.
+	StatePassword  = "password_protected" // StatePassword defines rooms which require a password.
+	StateInvisible = "invisible"          // StateInvisible defines rooms hidden to default users.
+)
+
+const (
+	TradeClosed = "closed"      // TradeClosed defines rooms where trading is not allowed.
+	TradeRights = "rights_only" // TradeRights defines rooms where only controllers can trade.
+	TradeOpen   = "open"        // TradeOpen defines rooms where everyone can trade.
+)
+
+// TagSeparator defines the separator of the room tags when stored.
+const TagSeparator = ";"
+
+// DoorOf provides the Nitro access state of a stored room state.
+func DoorOf(state string) encode.Door {
+	switch state {
+	case StateLocked:
+		return encode.Locked
+	case StatePassword:
+		return encode.PasswordProtected
+	case StateInvisible:
+		return encode.Invisible
+	default:
+		return encode.Open
+	}
+}
+
+// StateOf provides the stored room state of a Nitro access state.
+func StateOf(d encode.Door) string {
+	switch d {
+	case encode.Locked:
+		return StateLocked
+	case encode.PasswordProtected:
+		return StatePassword
+	case encode.Invisible:
+		return StateInvisible
+	default:
+		return StateOpen
+	}
+}
+
+// TradeOf provides the Nitro trade mode of a stored trade mode.
+func TradeOf(mode string) int32 {
+	switch mode {
+	case TradeRights:
+		return 1
+	case TradeOpen:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// TradeModeOf provides the stored trade mode of a Nitro trade mode.
+func TradeModeOf(mode int32) string {
+	switch mode {
+	case 1:
+		return TradeRights
+	case 2:
+		return TradeOpen
+	default:
+		return TradeClosed
+	}
+}
+
+// SplitTags provides the tags of a stored room.
+func SplitTags(tags string) []string {
+	res := make([]string, 0)
+	for _, t := range strings.Split(tags, TagSeparator) {
+		if t = strings.TrimSpace(t); t != "" {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+// JoinTags provides the stored value of room tags.
+func JoinTags(tags []string) string {
+	return strings.Join(tags, TagSeparator)
+}
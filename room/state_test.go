@@ -0,0 +1,30 @@
+package room
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/room/encode"
+)
+
+// TestDoorOf verifies stored states and Nitro access states are mapped both ways.
+func TestDoorOf(t *testing.T) {
+	for _, s := range []string{StateOpen, StateLocked, StatePassword, StateInvisible} {
+		assert.Equal(t, s, StateOf(DoorOf(s)))
+	}
+	assert.Equal(t, encode.Door(encode.Open), DoorOf("unknown"))
+}
+
+// TestTradeOf verifies stored and Nitro trade modes are mapped both ways.
+func TestTradeOf(t *testing.T) {
+	for _, m := range []string{TradeClosed, TradeRights, TradeOpen} {
+		assert.Equal(t, m, TradeModeOf(TradeOf(m)))
+	}
+}
+
+// TestSplitTags verifies empty tags are discarded.
+func TestSplitTags(t *testing.T) {
+	assert.Equal(t, []string{"fun", "game"}, SplitTags(" fun;;game;"))
+	assert.Empty(t, SplitTags(""))
+	assert.Equal(t, "fun;game", JoinTags([]string{"fun", "game"}))
+}
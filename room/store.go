@@ -12,10 +12,14 @@ type Store interface {
 
 	// Limits provide the attempt limiter for the store.
 	Limits() *util.AttemptLimiter
+
+	// Loads provide the locks serializing the room loads by identifier.
+	Loads() *util.KeyedMutex
 }
 
 type MemoryStore struct {
 	PassLimit *util.AttemptLimiter
+	LoadLock  *util.KeyedMutex
 	store.AsyncStore[*Room]
 }
 
@@ -27,9 +31,14 @@ func (m *MemoryStore) Limits() *util.AttemptLimiter {
 	return m.PassLimit
 }
 
+func (m *MemoryStore) Loads() *util.KeyedMutex {
+	return m.LoadLock
+}
+
 func NewRoomStore() Store {
 	return &MemoryStore{
 		PassLimit:  util.NewAttemptLimiter(),
+		LoadLock:   util.NewKeyedMutex(),
 		AsyncStore: store.NewMemoryStore[*Room](),
 	}
 }
@@ -0,0 +1,74 @@
+package room
+
+import (
+	"sort"
+	"strings"
+)
+
+// TagCount defines the popularity of a tag among live rooms.
+type TagCount struct {
+	Tag   string // Tag is the normalized room tag.
+	Count int    // Count is the amount of players in rooms with the tag.
+}
+
+// NormalizeTag provides the stored form of a tag, lower case and with
+// the inner whitespace collapsed.
+func NormalizeTag(tag string) string {
+	return strings.Join(strings.Fields(strings.ToLower(tag)), " ")
+}
+
+// NormalizeTags normalizes every tag, discarding the repeated ones.
+func NormalizeTags(tags []string) []string {
+	res := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		t = NormalizeTag(t)
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		res = append(res, t)
+	}
+	return res
+}
+
+// PopularTags provides the tags of the given rooms weighted by their players,
+// most popular first. Invisible and empty rooms are ignored.
+func PopularTags(rooms []*Room, limit int) []TagCount {
+
+	counts := make(map[string]int)
+	for _, r := range rooms {
+		if r == nil {
+			continue
+		}
+		d := r.Data()
+		if d.State == StateInvisible {
+			continue
+		}
+		n := r.Population()
+		if n == 0 {
+			continue
+		}
+		for _, t := range SplitTags(d.Tags) {
+			counts[NormalizeTag(t)] += n
+		}
+	}
+
+	res := make([]TagCount, 0, len(counts))
+	for t, c := range counts {
+		res = append(res, TagCount{Tag: t, Count: c})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Count != res[j].Count {
+			return res[i].Count > res[j].Count
+		}
+		return res[i].Tag < res[j].Tag
+	})
+
+	if limit > 0 && len(res) > limit {
+		res = res[:limit]
+	}
+	return res
+
+}
@@ -0,0 +1,59 @@
+package room
+
+import (
+	"pixels-emulator/core/model"
+	"pixels-emulator/room/encode"
+	"pixels-emulator/room/message/settings"
+	"pixels-emulator/user"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNormalizeTags verifies tags are lower cased, trimmed and not repeated.
+func TestNormalizeTags(t *testing.T) {
+	assert.Equal(t, "role play", NormalizeTag("  Role   PLAY "))
+	assert.Equal(t, []string{"fun", "games"}, NormalizeTags([]string{"Fun", " fun ", "GAMES"}))
+}
+
+// TestRoom_ValidateSettings_Tags verifies tags are normalized before being limited.
+func TestRoom_ValidateSettings_Tags(t *testing.T) {
+	r := &Room{}
+	s := &settings.Settings{Name: "Lobby", Door: encode.Open, UsersMax: 10, Tags: []string{"Fun", "fun ", "Games"}}
+
+	_, ok := r.ValidateSettings(s, 50)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"fun", "games"}, s.Tags)
+
+	s.Tags = []string{"one", "two", "three"}
+	code, ok := r.ValidateSettings(s, 50)
+	assert.False(t, ok)
+	assert.Equal(t, InvalidTag, code)
+
+	s.Tags = []string{"a tag longer than twenty runes"}
+	code, ok = r.ValidateSettings(s, 50)
+	assert.False(t, ok)
+	assert.Equal(t, TooLongTag, code)
+}
+
+// TestPopularTags verifies tags are weighted by the players of visible rooms.
+func TestPopularTags(t *testing.T) {
+	players := func(n int) map[string]*user.Player {
+		res := make(map[string]*user.Player, n)
+		for i := 0; i < n; i++ {
+			res[string(rune('a'+i))] = &user.Player{}
+		}
+		return res
+	}
+
+	rooms := []*Room{
+		{data: model.Room{Tags: "fun;games"}, Players: players(2)},
+		{data: model.Room{Tags: "games"}, Players: players(3)},
+		{data: model.Room{Tags: "party"}, Players: players(1)},
+		{data: model.Room{Tags: "hidden", State: StateInvisible}, Players: players(4)},
+		{data: model.Room{Tags: "empty"}, Players: players(0)},
+	}
+
+	assert.Equal(t, []TagCount{{Tag: "games", Count: 5}, {Tag: "fun", Count: 2}, {Tag: "party", Count: 1}}, PopularTags(rooms, 0))
+	assert.Len(t, PopularTags(rooms, 1), 1)
+}
@@ -7,13 +7,18 @@ type Unit struct {
 	Status     map[Status]string // Status defines the actual movement control status.
 	head, body path.Direction    // head, body defines the corporal rotation.
 	Current    path.Coordinate
-	Request    path.Request
+	Request    *path.Request // Request defines the path the unit is walking, nil if standing.
 }
 
 func (u *Unit) GetCurrentTile(l *path.Layout) *path.Tile {
 	return l.GetTile(int(u.Current.X()), int(u.Current.Y()))
 }
 
+// Walking checks if the unit has a path pending to walk.
+func (u *Unit) Walking() bool {
+	return u.Request != nil
+}
+
 // SetRotation updates the head and body rotation.
 func (u *Unit) SetRotation(head path.Direction, body path.Direction) {
 	u.head = head
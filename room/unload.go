@@ -0,0 +1,115 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"pixels-emulator/core/model"
+	ev "pixels-emulator/room/event"
+	"time"
+)
+
+// ErrRoomOccupied rejects unloading rooms with players.
+var ErrRoomOccupied = errors.New("room is not empty")
+
+// roomColumns are the room record columns owned by the loaded room.
+var roomColumns = []string{"name", "description", "password", "state", "users_max", "tags", "category_id", "layout_id"}
+
+// configurationColumns are the configuration columns owned by the loaded room.
+var configurationColumns = []string{
+	"wall_thickness", "wall_height", "floor_thickness", "allow_pets", "allow_pets_feed", "allow_walk_through",
+	"allow_hide_wall", "chat_mode", "chat_weight", "chat_speed", "chat_hearing_distance", "chat_protection", "trade_mode",
+}
+
+// own copies the columns owned by the loaded room from a stored version of its data.
+func own(d *model.Room, stored model.Room) {
+	d.Name, d.Description, d.Password, d.State = stored.Name, stored.Description, stored.Password, stored.State
+	d.UsersMax, d.Tags, d.CategoryID = stored.UsersMax, stored.Tags, stored.CategoryID
+	d.LayoutId, d.Layout = stored.LayoutId, stored.Layout
+	d.Configuration = stored.Configuration
+}
+
+// MarkDirty flags the in-memory room state as pending to be persisted.
+func (r *Room) MarkDirty() {
+	r.dirty.Store(true)
+}
+
+// Dirty checks if the in-memory room state is pending to be persisted.
+func (r *Room) Dirty() bool {
+	return r.dirty.Load()
+}
+
+// Idle provides how long the room has been empty, zero if not empty.
+func (r *Room) Idle(now time.Time) time.Duration {
+	since := r.emptySince.Load()
+	if since == 0 || !r.Empty() {
+		return 0
+	}
+	return now.Sub(time.UnixMilli(since))
+}
+
+// Flush persists the dirty in-memory state of the room. Only the settings and the
+// layout are written, as the rest of the room record is updated on its own.
+func (r *Room) Flush(ctx context.Context, db *gorm.DB) error {
+
+	if !r.dirty.CompareAndSwap(true, false) {
+		return nil
+	}
+
+	r.saveMu.Lock()
+	defer r.saveMu.Unlock()
+
+	d := r.Data()
+	err := r.save(ctx, db, &d)
+	if err != nil {
+		r.dirty.Store(true)
+	}
+
+	return err
+
+}
+
+// save writes the columns owned by the loaded room from a version of its data.
+// The save lock must be held, so the version is not replaced meanwhile.
+func (r *Room) save(ctx context.Context, db *gorm.DB, d *model.Room) error {
+
+	err := db.WithContext(ctx).Model(&model.Room{}).Where("id = ?", r.Id).
+		Select(roomColumns).Updates(d).Error
+	if err != nil {
+		return err
+	}
+
+	d.Configuration.RoomID = r.Id
+	return db.WithContext(ctx).Model(&model.RoomConfiguration{}).Where("room_id = ?", r.Id).
+		Select(configurationColumns).Updates(&d.Configuration).Error
+
+}
+
+// Unload flushes the room state, removing it from the store and the cycle engine.
+// The room is only unloaded while empty, and refuses further players once unloaded
+// so they load it again from the database.
+func Unload(ctx context.Context, rs Store, r *Room, db *gorm.DB) error {
+
+	r.mu.Lock()
+	if !r.empty() {
+		r.mu.Unlock()
+		return ErrRoomOccupied
+	}
+
+	if err := r.Flush(ctx, db); err != nil {
+		r.mu.Unlock()
+		return err
+	}
+
+	r.tk.Unregister(r.key())
+	if err := rs.Records().Delete(ctx, r.key()); err != nil {
+		r.mu.Unlock()
+		return err
+	}
+	r.unloaded = true
+	r.mu.Unlock()
+
+	r.em.Fire(ev.RoomUnloadEventName, ev.NewRoomUnloadEvent(r.Id, 0, make(map[string]string)))
+	return nil
+
+}
@@ -0,0 +1,71 @@
+package room
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	eventMock "pixels-emulator/core/event/mock"
+	"pixels-emulator/room/event"
+)
+
+// TestUnload verifies only empty rooms are unloaded, refusing the players afterward.
+func TestUnload(t *testing.T) {
+	ctx := context.Background()
+	em := &eventMock.MockEventManager{}
+	em.On("Fire", event.RoomUnloadEventName, mock.Anything).Return()
+
+	r := testRoom(t)
+	r.em = em
+	rs := NewRoomStore()
+	assert.NoError(t, rs.Records().Create(ctx, r.key(), r))
+
+	p := testPlayer(1)
+	r.Players[p.Id] = p
+	assert.ErrorIs(t, Unload(ctx, rs, r, nil), ErrRoomOccupied)
+	_, err := rs.Records().Read(ctx, r.key())
+	assert.NoError(t, err, "occupied rooms stay loaded")
+
+	delete(r.Players, p.Id)
+	assert.NoError(t, Unload(ctx, rs, r, nil))
+	_, err = rs.Records().Read(ctx, r.key())
+	assert.Error(t, err)
+	em.AssertCalled(t, "Fire", event.RoomUnloadEventName, mock.Anything)
+
+	assert.False(t, r.Open(p, nil, false), "unloaded rooms refuse the players")
+	assert.False(t, r.Open(p, nil, true))
+	assert.False(t, r.IsOnline(p))
+	assert.True(t, r.Empty())
+}
+
+// TestRoom_Flush verifies only the columns owned by the loaded room are written.
+func TestRoom_Flush(t *testing.T) {
+	db, err := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true, SkipDefaultTransaction: true})
+	assert.NoError(t, err)
+
+	var sqls []string
+	err = db.Callback().Update().After("gorm:update").Register("test:flush", func(tx *gorm.DB) {
+		sqls = append(sqls, tx.Statement.SQL.String())
+	})
+	assert.NoError(t, err)
+
+	r := testRoom(t)
+	r.data.Name = "Lobby"
+	r.MarkDirty()
+	assert.NoError(t, r.Flush(context.Background(), db))
+	assert.False(t, r.Dirty())
+
+	assert.Len(t, sqls, 2)
+	assert.Contains(t, sqls[0], "UPDATE `rooms` SET")
+	assert.Contains(t, sqls[0], "`name`=?")
+	assert.Contains(t, sqls[0], "`layout_id`=?")
+	assert.NotContains(t, sqls[0], "`score`")
+	assert.NotContains(t, sqls[0], "`owner_id`")
+	assert.Contains(t, sqls[1], "UPDATE `room_configurations`")
+	assert.Contains(t, sqls[1], "`chat_mode`=?")
+	assert.NotContains(t, sqls[1], "`light_data`")
+	assert.NotContains(t, sqls[1], "`floor_paper`")
+}
@@ -5,7 +5,7 @@ import (
 	"pixels-emulator/user"
 )
 
-// GetUserRoom provides the related user room, if queuing, transitioning or in-game.
+// GetUserRoom provides the related user room, if ringing, queuing, transitioning or in-game.
 func GetUserRoom(ctx context.Context, rs Store, p *user.Player) (*Room, error) {
 
 	r, err := rs.Records().GetAll(ctx)
@@ -19,6 +19,10 @@ func GetUserRoom(ctx context.Context, rs Store, p *user.Player) (*Room, error) {
 			return r, nil
 		}
 
+		if r.IsRinging(p) {
+			return r, nil
+		}
+
 		if r.IsTransitioning(p) {
 			return r, nil
 		}
@@ -0,0 +1,15 @@
+package room
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"pixels-emulator/core/model"
+)
+
+// Visit records a user entering a room, refreshing the previous visit if any.
+func Visit(ctx context.Context, db *gorm.DB, room, user uint) error {
+	return db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.AssignmentColumns([]string{"updated_at"}),
+	}).Create(&model.RoomVisit{RoomID: room, UserID: user}).Error
+}
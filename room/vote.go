@@ -0,0 +1,122 @@
+package room
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"pixels-emulator/core/model"
+	"pixels-emulator/room/message/score"
+	"pixels-emulator/user"
+	"strconv"
+)
+
+// Vote adds the vote of a user to the room score. Returns false if the user already voted.
+func (r *Room) Vote(ctx context.Context, db *gorm.DB, uid uint) (bool, error) {
+
+	voted := false
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.RoomVote{RoomID: r.Id, UserID: uid})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		voted = true
+		return tx.Model(&model.Room{}).Where("id = ?", r.Id).UpdateColumn("score", gorm.Expr("score + 1")).Error
+	})
+
+	if err != nil || !voted {
+		return false, err
+	}
+
+	r.update(func(d *model.Room) {
+		d.Score++
+	})
+	return true, nil
+
+}
+
+// Voted checks if a user already voted a room.
+func Voted(ctx context.Context, db *gorm.DB, room, uid uint) (bool, error) {
+	var n int64
+	err := db.WithContext(ctx).Model(&model.RoomVote{}).Where("room_id = ? AND user_id = ?", room, uid).Count(&n).Error
+	return n > 0, err
+}
+
+// Voters provides which of the users already voted a room.
+func Voters(ctx context.Context, db *gorm.DB, room uint, uids []uint) (map[uint]struct{}, error) {
+
+	res := make(map[uint]struct{})
+	if len(uids) == 0 {
+		return res, nil
+	}
+
+	var voters []uint
+	err := db.WithContext(ctx).Model(&model.RoomVote{}).Where("room_id = ? AND user_id IN ?", room, uids).Pluck("user_id", &voters).Error
+	for _, v := range voters {
+		res[v] = struct{}{}
+	}
+	return res, err
+
+}
+
+// SendScore sends the room score to an in-game player. The like button is
+// shown to players who have not voted yet, except for the owner.
+func (r *Room) SendScore(ctx context.Context, db *gorm.DB, p *user.Player) error {
+
+	uid, err := strconv.Atoi(p.Id)
+	if err != nil {
+		return err
+	}
+
+	voted, err := Voted(ctx, db, r.Id, uint(uid))
+	if err != nil {
+		return err
+	}
+
+	d := r.Data()
+	p.Conn().SendPacket(&score.ScorePacket{
+		Score:   int32(d.Score),
+		CanLike: !voted && d.OwnerID != uint(uid),
+	})
+	return nil
+
+}
+
+// BroadcastScore sends the room score to the in-game players after a vote. The voter
+// is known to have voted, while the other players are checked along.
+func (r *Room) BroadcastScore(ctx context.Context, db *gorm.DB, voter uint) error {
+
+	players := r.Online()
+	uids := make(map[string]uint, len(players))
+	others := make([]uint, 0, len(players))
+	for _, p := range players {
+		uid, err := strconv.Atoi(p.Id)
+		if err != nil {
+			continue
+		}
+		uids[p.Id] = uint(uid)
+		if uint(uid) != voter {
+			others = append(others, uint(uid))
+		}
+	}
+
+	voters, err := Voters(ctx, db, r.Id, others)
+	if err != nil {
+		return err
+	}
+	voters[voter] = struct{}{}
+
+	d := r.Data()
+	for _, p := range players {
+		uid, ok := uids[p.Id]
+		if !ok {
+			continue
+		}
+		_, voted := voters[uid]
+		p.Conn().SendPacket(&score.ScorePacket{
+			Score:   int32(d.Score),
+			CanLike: !voted && d.OwnerID != uid,
+		})
+	}
+	return nil
+
+}
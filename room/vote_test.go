@@ -0,0 +1,45 @@
+package room
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	protocolMock "pixels-emulator/core/protocol/mock"
+	"pixels-emulator/room/message/score"
+	"pixels-emulator/user"
+)
+
+// TestRoom_BroadcastScore verifies the players are checked with a single query,
+// only showing the like button to the players who have not voted yet.
+func TestRoom_BroadcastScore(t *testing.T) {
+	db, err := gorm.Open(mysql.New(mysql.Config{SkipInitializeWithVersion: true}), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	assert.NoError(t, err)
+
+	var queries []string
+	assert.NoError(t, db.Callback().Query().After("gorm:query").Register("test:capture", func(tx *gorm.DB) {
+		queries = append(queries, tx.Statement.SQL.String())
+	}))
+
+	r := testRoom(t)
+	r.data.Score = 4
+	r.data.OwnerID = 1
+
+	players := []*user.Player{testPlayer(1), testPlayer(2), testPlayer(3)}
+	for _, p := range players {
+		r.Players[p.Id] = p
+	}
+
+	assert.NoError(t, r.BroadcastScore(context.Background(), db, 2))
+	assert.Len(t, queries, 1)
+	assert.Contains(t, queries[0], "user_id IN (?,?)")
+
+	likes := map[string]bool{"1": false, "2": false, "3": true}
+	for _, p := range players {
+		pck := p.Conn().(*protocolMock.MockConnection).Calls[0].Arguments.Get(0).(*score.ScorePacket)
+		assert.Equal(t, int32(4), pck.Score)
+		assert.Equal(t, likes[p.Id], pck.CanLike, p.Id)
+	}
+}
@@ -0,0 +1,109 @@
+package room
+
+import (
+	"fmt"
+	"go.uber.org/zap"
+	"pixels-emulator/room/encode"
+	msg "pixels-emulator/room/message/unit"
+	"pixels-emulator/room/path"
+	"pixels-emulator/room/unit"
+	"pixels-emulator/user"
+)
+
+// walk advances every walking unit of the room one tile and
+// broadcasts the updated statuses to the players in-game.
+func (r *Room) walk() {
+
+	r.mu.Lock()
+	var units []encode.UnitMessage
+	for _, p := range r.Players {
+
+		if !r.step(p) {
+			continue
+		}
+
+		enc, err := EncodeUnit(p.Unit())
+		if err != nil {
+			r.logger.Error("Error encoding walking unit", zap.String("identifier", p.Id), zap.Error(err))
+			continue
+		}
+		units = append(units, *enc)
+
+		// Position is updated after encoding, so the client interpolates
+		// the unit from the current tile to the one at the movement status.
+		if _, ok := p.Unit().Status[unit.Move]; ok {
+			r.place(p, p.Unit().Request.Path()[0])
+		}
+
+	}
+	r.mu.Unlock()
+
+	if len(units) == 0 {
+		return
+	}
+
+	r.Broadcast(&msg.UpdateStatusPacket{Units: units})
+
+}
+
+// Walk requests a player unit to walk towards a tile of the layout on the next cycles.
+// Returns false if the target cannot be reached.
+func (r *Room) Walk(p *user.Player, x, y int) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return p.Move(r.l, x, y)
+}
+
+// step calculates the next movement status of a player unit.
+// Returns true if the unit status changed and must be notified.
+func (r *Room) step(p *user.Player) bool {
+
+	u := p.Unit()
+	_, moved := u.Status[unit.Move]
+	delete(u.Status, unit.Move)
+
+	if !u.Walking() {
+		return moved
+	}
+
+	l := r.l
+	current := u.GetCurrentTile(l)
+	target := l.GetTile(int(u.Request.Target().X()), int(u.Request.Target().Y()))
+	next := u.Request.Next()
+
+	if next == nil || current == nil {
+		u.Request = nil
+		return moved
+	}
+
+	// Path could be blocked by other units since calculated, so it is calculated again.
+	if !next.Walkable(path.AllowFalling, current, u.Request.Finished()) {
+		req := path.NewRequest(l, current, target, u, false)
+		if req.CalculatePath(true) == nil || req.Next() == nil {
+			u.Request = nil
+			return moved
+		}
+		u.Request = req
+		next = req.Path()[0]
+	}
+
+	dir := path.CalculateDirection(current, next)
+	u.SetRotation(dir, dir)
+	u.Status[unit.Move] = fmt.Sprintf("%d,%d,%g", next.X, next.Y, next.Height())
+	return true
+
+}
+
+// place moves a player unit to a tile of the layout.
+func (r *Room) place(p *user.Player, t *path.Tile) {
+
+	u := p.Unit()
+	if current := u.GetCurrentTile(r.l); current != nil {
+		current.RemoveUnit(p.Id)
+	}
+
+	h, _ := u.Rotation()
+	u.Current = path.NewCoordinate(t.X, t.Y, int16(t.Height()), h)
+	t.AddUnit(p.Id)
+
+}
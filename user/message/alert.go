@@ -0,0 +1,34 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// AlertCode is the unique identifier for the packet
+const AlertCode = 3801
+
+// AlertPacket sends to the user a broadcast styled notification with a custom message.
+type AlertPacket struct {
+	Message string // Message is the text of the notification.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *AlertPacket) Id() uint16 {
+	return AlertCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *AlertPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *AlertPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *AlertPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(AlertCode)
+	pck.AddString(p.Message)
+	return pck
+}
@@ -0,0 +1,31 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestAlertPacket_Serialize verifies the packet serialization.
+func TestAlertPacket_Serialize(t *testing.T) {
+	pck := &AlertPacket{Message: "value1"}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+	assert.Equal(t, uint16(AlertCode), raw.GetHeader())
+
+	m0, err := raw.ReadString()
+	assert.NoError(t, err)
+	assert.Equal(t, "value1", m0)
+}
+
+// TestAlertPacket_Integrity tests packet ID, deadline, and rate.
+func TestAlertPacket_Integrity(t *testing.T) {
+	pck := &AlertPacket{}
+	assert.Equal(t, uint16(AlertCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,37 @@
+package message
+
+import (
+	"pixels-emulator/core/protocol"
+	"strconv"
+)
+
+// CreditsCode is the unique identifier for the packet
+const CreditsCode = 3475
+
+// CreditsPacket provides the credits balance of the user.
+type CreditsPacket struct {
+	Credits int32 // Credits is the balance of credits.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *CreditsPacket) Id() uint16 {
+	return CreditsCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *CreditsPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *CreditsPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte. Nitro reads the balance as a decimal text.
+func (p *CreditsPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(CreditsCode)
+	pck.AddString(strconv.Itoa(int(p.Credits)) + ".0")
+	return pck
+}
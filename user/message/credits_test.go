@@ -0,0 +1,30 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestCreditsPacket_Serialize verifies the packet serialization.
+func TestCreditsPacket_Serialize(t *testing.T) {
+	pck := &CreditsPacket{Credits: 150}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	balance, err := raw.ReadString()
+	assert.NoError(t, err)
+	assert.Equal(t, "150.0", balance)
+}
+
+// TestCreditsPacket_Integrity tests packet ID, deadline, and rate.
+func TestCreditsPacket_Integrity(t *testing.T) {
+	pck := &CreditsPacket{}
+	assert.Equal(t, uint16(CreditsCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -0,0 +1,44 @@
+package message
+
+import "pixels-emulator/core/protocol"
+
+// CurrencyCode is the unique identifier for the packet
+const CurrencyCode = 2018
+
+// Currency defines the balance of an activity points currency.
+type Currency struct {
+	Type   int32 // Type is the currency of the activity points.
+	Amount int32 // Amount is the balance of the currency.
+}
+
+// CurrencyPacket provides the activity points balances of the user.
+type CurrencyPacket struct {
+	Currencies []Currency // Currencies are the balances of each currency.
+	protocol.Packet
+}
+
+// Id returns the unique identifier of the Packet type.
+func (p *CurrencyPacket) Id() uint16 {
+	return CurrencyCode
+}
+
+// Rate returns the rate limit for the packet.
+func (p *CurrencyPacket) Rate() (uint16, uint16) {
+	return 0, 0
+}
+
+// Deadline provides the maximum time a packet can be processed in milliseconds.
+func (p *CurrencyPacket) Deadline() uint {
+	return 0
+}
+
+// Serialize transforms packet in byte.
+func (p *CurrencyPacket) Serialize() protocol.RawPacket {
+	pck := protocol.NewPacket(CurrencyCode)
+	pck.AddInt(int32(len(p.Currencies)))
+	for _, c := range p.Currencies {
+		pck.AddInt(c.Type)
+		pck.AddInt(c.Amount)
+	}
+	return pck
+}
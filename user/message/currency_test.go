@@ -0,0 +1,35 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pixels-emulator/core/protocol"
+)
+
+// TestCurrencyPacket_Serialize verifies the packet serialization.
+func TestCurrencyPacket_Serialize(t *testing.T) {
+	pck := &CurrencyPacket{Currencies: []Currency{{Type: 0, Amount: 20}, {Type: 5, Amount: 7}}}
+	enc := pck.Serialize()
+	raw, err := protocol.FromBytes(enc.ToBytes())
+	assert.NoError(t, err)
+
+	n, _ := raw.ReadInt()
+	assert.Equal(t, int32(2), n)
+	for _, c := range pck.Currencies {
+		kind, _ := raw.ReadInt()
+		amount, err := raw.ReadInt()
+		assert.NoError(t, err)
+		assert.Equal(t, c, Currency{Type: kind, Amount: amount})
+	}
+}
+
+// TestCurrencyPacket_Integrity tests packet ID, deadline, and rate.
+func TestCurrencyPacket_Integrity(t *testing.T) {
+	pck := &CurrencyPacket{}
+	assert.Equal(t, uint16(CurrencyCode), pck.Id())
+	assert.Equal(t, uint(0), pck.Deadline())
+	mn, mx := pck.Rate()
+	assert.Equal(t, uint16(0), mn)
+	assert.Equal(t, uint16(0), mx)
+}
@@ -10,6 +10,9 @@ const GenericErrorCode = 1600
 // WrongPasswordCode is the generic code for room access denying.
 const WrongPasswordCode = -100002
 
+// KickedCode is the generic code for users kicked from a room.
+const KickedCode = 4008
+
 // GenericErrorPacket send to the user a generic error. (Nitro did it dirty, only few codes... No customization at all)
 type GenericErrorPacket struct {
 	Code int32
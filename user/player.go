@@ -2,24 +2,33 @@ package user
 
 import (
 	"context"
+	"gorm.io/gorm"
 	"pixels-emulator/core/database"
 	"pixels-emulator/core/model"
 	"pixels-emulator/core/protocol"
 	"pixels-emulator/core/scheduler"
+	"pixels-emulator/inventory"
+	"pixels-emulator/room/path"
 	"pixels-emulator/room/unit"
 	"strconv"
+	"sync"
+	"sync/atomic"
 )
 
 // Player defines an ephemeral room which will be
 // stored in memory for in-game modifications.
 type Player struct {
-	Id string // Id is the identifier of the room
+	Id       string // Id is the identifier of the room
+	Username string // Username is the name of the player when loaded.
 
 	// Private dependencies
-	conn protocol.Connection              // conn defines the connection of the player.
-	cr   scheduler.Scheduler              // cr defines the scheduler for movement.
-	svc  database.DataService[model.User] // svc defines the user service to query.
-	unit *unit.Unit                       // unit defines the player unit
+	conn  protocol.Connection              // conn defines the connection of the player.
+	cr    scheduler.Scheduler              // cr defines the scheduler for movement.
+	svc   database.DataService[model.User] // svc defines the user service to query.
+	unit  *unit.Unit                       // unit defines the player unit
+	inv   *inventory.Inventory             // inv defines the player items, nil until requested.
+	invMu sync.Mutex                       // invMu guards the inventory load.
+	page  atomic.Uint32                    // page defines the last catalog page opened.
 }
 
 func (p *Player) Record(ctx context.Context) <-chan struct {
@@ -40,8 +49,85 @@ func (p *Player) Record(ctx context.Context) <-chan struct {
 	return p.svc.Get(ctx, uint(id))
 }
 
-func (p *Player) Move() {
+// Move calculates a path from the current unit position to the target
+// coordinates of the layout, which will be walked on further room cycles.
+// Returns false if the target cannot be reached.
+func (p *Player) Move(l *path.Layout, x, y int) bool {
 
+	if l == nil || !l.TileExists(x, y) {
+		return false
+	}
+
+	base := p.unit.GetCurrentTile(l)
+	target := l.GetTile(x, y)
+	if base == nil || base == target {
+		return false
+	}
+
+	req := path.NewRequest(l, base, target, p.unit, false)
+	if req.CalculatePath(true) == nil {
+		return false
+	}
+
+	p.unit.Request = req
+	return true
+
+}
+
+// Inventory provides the items of the player, loaded from the database on first request.
+func (p *Player) Inventory(ctx context.Context, db *gorm.DB, capacity int) (*inventory.Inventory, error) {
+
+	p.invMu.Lock()
+	defer p.invMu.Unlock()
+
+	if p.inv != nil {
+		return p.inv, nil
+	}
+
+	return p.loadInventory(ctx, db, capacity)
+
+}
+
+// ReloadInventory loads again the items of the player, replacing the loaded ones.
+func (p *Player) ReloadInventory(ctx context.Context, db *gorm.DB, capacity int) (*inventory.Inventory, error) {
+	p.invMu.Lock()
+	defer p.invMu.Unlock()
+	return p.loadInventory(ctx, db, capacity)
+}
+
+// loadInventory loads the items of the player from the database. The inventory lock must be held.
+func (p *Player) loadInventory(ctx context.Context, db *gorm.DB, capacity int) (*inventory.Inventory, error) {
+
+	id, err := strconv.Atoi(p.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := inventory.Load(ctx, db, uint(id), capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	p.inv = inv
+	return inv, nil
+
+}
+
+// LoadedInventory provides the items of the player if already requested, nil otherwise.
+func (p *Player) LoadedInventory() *inventory.Inventory {
+	p.invMu.Lock()
+	defer p.invMu.Unlock()
+	return p.inv
+}
+
+// OpenCatalogPage keeps the catalog page the player is looking at.
+func (p *Player) OpenCatalogPage(id uint) {
+	p.page.Store(uint32(id))
+}
+
+// CatalogPage provides the last catalog page opened by the player, zero if none.
+func (p *Player) CatalogPage() uint {
+	return uint(p.page.Load())
 }
 
 func (p *Player) Unit() *unit.Unit {
@@ -62,10 +148,11 @@ func Load(
 ) *Player {
 	id := strconv.Itoa(int(user.ID))
 	return &Player{
-		Id:   id,
-		conn: conn,
-		unit: unit.NewUnit(int32(user.ID)),
-		cr:   cr,
-		svc:  svc,
+		Id:       id,
+		Username: user.Username,
+		conn:     conn,
+		unit:     unit.NewUnit(int32(user.ID)),
+		cr:       cr,
+		svc:      svc,
 	}
 }